@@ -0,0 +1,150 @@
+package iter
+
+import (
+	"cmp"
+	"math"
+)
+
+// kahan accumulates a sum using the Kahan compensated summation,
+// limiting the error introduced by adding floating-point numbers.
+// For integers the compensation is always zero.
+type kahan[E Number] struct {
+	sum, c E
+}
+
+func (k *kahan[E]) add(e E) {
+	y := e - k.c
+	t := k.sum + y
+	if math.IsInf(float64(t), 0) {
+		// the compensation of an infinite sum would be NaN
+		k.sum, k.c = t, 0
+		return
+	}
+	k.c = (t - k.sum) - y
+	k.sum = t
+}
+
+// Sum returns the sum of the elements of the iterator, and false
+// if it is empty. Floating-point numbers are summed using the Kahan
+// compensated summation.
+func Sum[E Number](it Iter[E]) (E, bool) {
+	var k kahan[E]
+	var ok bool
+	for e, more := it.Next(); more; e, more = it.Next() {
+		k.add(e)
+		ok = true
+	}
+	return k.sum, ok
+}
+
+// Prod returns the product of the elements of the iterator, and false
+// if it is empty. It is not named Product, which is the cartesian product.
+func Prod[E Number](it Iter[E]) (E, bool) {
+	e, ok := it.Next()
+	if !ok {
+		return zero[E](), false
+	}
+	return Reduce(it, func(a, b E) E { return a * b }, e), true
+}
+
+// Mean returns the arithmetic mean of the elements of the iterator,
+// and false if it is empty. The elements are summed as float64 using
+// the Kahan compensated summation.
+func Mean[E Number](it Iter[E]) (float64, bool) {
+	var k kahan[float64]
+	var n int
+	for e, ok := it.Next(); ok; e, ok = it.Next() {
+		k.add(float64(e))
+		n++
+	}
+	if n == 0 {
+		return 0, false
+	}
+	return k.sum / float64(n), true
+}
+
+// Min returns the smallest element of the iterator, and false if it is empty.
+// If more elements are the smallest, the first one is returned.
+func Min[E cmp.Ordered](it Iter[E]) (E, bool) {
+	return MinBy(it, cmp.Less[E])
+}
+
+// Max returns the largest element of the iterator, and false if it is empty.
+// If more elements are the largest, the first one is returned.
+func Max[E cmp.Ordered](it Iter[E]) (E, bool) {
+	return MaxBy(it, cmp.Less[E])
+}
+
+// MinBy is like Min, but uses the provided function to compare the elements.
+func MinBy[E any](it Iter[E], less func(a, b E) bool) (E, bool) {
+	e, ok := ArgMinBy(it, less)
+	return e.Value, ok
+}
+
+// MaxBy is like Max, but uses the provided function to compare the elements.
+func MaxBy[E any](it Iter[E], less func(a, b E) bool) (E, bool) {
+	e, ok := ArgMaxBy(it, less)
+	return e.Value, ok
+}
+
+// MinMax returns both the smallest and the largest element
+// of the iterator in a single pass, and false if it is empty.
+func MinMax[E cmp.Ordered](it Iter[E]) (E, E, bool) {
+	return MinMaxBy(it, cmp.Less[E])
+}
+
+// MinMaxBy is like MinMax, but uses the provided function to compare the elements.
+func MinMaxBy[E any](it Iter[E], less func(a, b E) bool) (E, E, bool) {
+	lo, ok := it.Next()
+	if !ok {
+		return zero[E](), zero[E](), false
+	}
+	hi := lo
+	for e, ok := it.Next(); ok; e, ok = it.Next() {
+		if less(e, lo) {
+			lo = e
+		}
+		if less(hi, e) {
+			hi = e
+		}
+	}
+	return lo, hi, true
+}
+
+// ArgMin returns the first smallest element of the iterator
+// together with its index, and false if it is empty.
+func ArgMin[E cmp.Ordered](it Iter[E]) (Enum[E], bool) {
+	return ArgMinBy(it, cmp.Less[E])
+}
+
+// ArgMax returns the first largest element of the iterator
+// together with its index, and false if it is empty.
+func ArgMax[E cmp.Ordered](it Iter[E]) (Enum[E], bool) {
+	return ArgMaxBy(it, cmp.Less[E])
+}
+
+// ArgMinBy is like ArgMin, but uses the provided function to compare the elements.
+func ArgMinBy[E any](it Iter[E], less func(a, b E) bool) (Enum[E], bool) {
+	return argBest(it, less)
+}
+
+// ArgMaxBy is like ArgMax, but uses the provided function to compare the elements.
+func ArgMaxBy[E any](it Iter[E], less func(a, b E) bool) (Enum[E], bool) {
+	return argBest(it, func(a, b E) bool { return less(b, a) })
+}
+
+// argBest returns the first element e of the iterator, with its index,
+// such that better(x, e) is false for all the elements x.
+func argBest[E any](it Iter[E], better func(a, b E) bool) (Enum[E], bool) {
+	en := Enumerate(it)
+	best, ok := en.Next()
+	if !ok {
+		return zero[Enum[E]](), false
+	}
+	for e, ok := en.Next(); ok; e, ok = en.Next() {
+		if better(e.Value, best.Value) {
+			best = e
+		}
+	}
+	return best, true
+}
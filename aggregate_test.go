@@ -0,0 +1,182 @@
+package iter_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/gmgigi96/iter"
+)
+
+func TestSum(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []int
+		expected int
+		ok       bool
+	}{
+		{"Sum multiple elements", []int{1, 2, 3, 4}, 10, true},
+		{"Sum single element", []int{-5}, -5, true},
+		{"Sum empty slice", []int{}, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, ok := iter.Sum(iter.FromSlice(tt.input))
+			if result != tt.expected || ok != tt.ok {
+				t.Errorf("Expected %v, %v, got %v, %v", tt.expected, tt.ok, result, ok)
+			}
+		})
+	}
+
+	floats := []struct {
+		name     string
+		input    []float64
+		expected float64
+	}{
+		{"Sum with positive infinity", []float64{math.Inf(1), 1}, math.Inf(1)},
+		{"Sum with negative infinity", []float64{1, math.Inf(-1)}, math.Inf(-1)},
+		{"Sum overflowing to infinity", []float64{1e308, 1e308, -1e308}, math.Inf(1)},
+	}
+
+	for _, tt := range floats {
+		t.Run(tt.name, func(t *testing.T) {
+			result, ok := iter.Sum(iter.FromSlice(tt.input))
+			if result != tt.expected || !ok {
+				t.Errorf("Expected %v, got %v, %v", tt.expected, result, ok)
+			}
+		})
+	}
+}
+
+func TestSumCompensated(t *testing.T) {
+	result, _ := iter.Sum(iter.Repeat(0.1, 10))
+	if result != 1.0 {
+		t.Errorf("Expected 1, got %v", result)
+	}
+}
+
+func TestProd(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []int
+		expected int
+		ok       bool
+	}{
+		{"Prod multiple elements", []int{1, 2, 3, 4}, 24, true},
+		{"Prod with zero", []int{5, 0, 3}, 0, true},
+		{"Prod empty slice", []int{}, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, ok := iter.Prod(iter.FromSlice(tt.input))
+			if result != tt.expected || ok != tt.ok {
+				t.Errorf("Expected %v, %v, got %v, %v", tt.expected, tt.ok, result, ok)
+			}
+		})
+	}
+}
+
+func TestMean(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []int8
+		expected float64
+		ok       bool
+	}{
+		{"Mean without overflow of the element type", []int8{100, 100, 101}, 100.33333333333333, true},
+		{"Mean of negative numbers", []int8{-1, -2}, -1.5, true},
+		{"Mean empty slice", []int8{}, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, ok := iter.Mean(iter.FromSlice(tt.input))
+			if result != tt.expected || ok != tt.ok {
+				t.Errorf("Expected %v, %v, got %v, %v", tt.expected, tt.ok, result, ok)
+			}
+		})
+	}
+
+	floats := []struct {
+		name     string
+		input    []float64
+		expected float64
+	}{
+		{"Mean with positive infinity", []float64{math.Inf(1), 1}, math.Inf(1)},
+		{"Mean with negative infinity", []float64{1, math.Inf(-1)}, math.Inf(-1)},
+		{"Mean overflowing to infinity", []float64{1e308, 1e308, -1e308}, math.Inf(1)},
+	}
+
+	for _, tt := range floats {
+		t.Run(tt.name, func(t *testing.T) {
+			result, ok := iter.Mean(iter.FromSlice(tt.input))
+			if result != tt.expected || !ok {
+				t.Errorf("Expected %v, got %v, %v", tt.expected, result, ok)
+			}
+		})
+	}
+}
+
+func TestMinMax(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  []int
+		lo, hi int
+		ok     bool
+	}{
+		{"MinMax multiple elements", []int{3, 1, 4, 1, 5, 9, 2}, 1, 9, true},
+		{"MinMax single element", []int{7}, 7, 7, true},
+		{"MinMax empty slice", []int{}, 0, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lo, ok := iter.Min(iter.FromSlice(tt.input))
+			if lo != tt.lo || ok != tt.ok {
+				t.Errorf("Expected min %v, %v, got %v, %v", tt.lo, tt.ok, lo, ok)
+			}
+			hi, ok := iter.Max(iter.FromSlice(tt.input))
+			if hi != tt.hi || ok != tt.ok {
+				t.Errorf("Expected max %v, %v, got %v, %v", tt.hi, tt.ok, hi, ok)
+			}
+			lo, hi, ok = iter.MinMax(iter.FromSlice(tt.input))
+			if lo != tt.lo || hi != tt.hi || ok != tt.ok {
+				t.Errorf("Expected %v, %v, %v, got %v, %v, %v", tt.lo, tt.hi, tt.ok, lo, hi, ok)
+			}
+		})
+	}
+}
+
+func TestMinMaxBy(t *testing.T) {
+	type person struct {
+		name string
+		age  int
+	}
+	people := []person{{"a", 30}, {"b", 20}, {"c", 40}, {"d", 20}, {"e", 40}}
+	byAge := func(p, q person) bool { return p.age < q.age }
+
+	if p, _ := iter.MinBy(iter.FromSlice(people), byAge); p.name != "b" {
+		t.Errorf("Expected b, got %v", p.name)
+	}
+	if p, _ := iter.MaxBy(iter.FromSlice(people), byAge); p.name != "c" {
+		t.Errorf("Expected c, got %v", p.name)
+	}
+	if lo, hi, _ := iter.MinMaxBy(iter.FromSlice(people), byAge); lo.name != "b" || hi.name != "c" {
+		t.Errorf("Expected b and c, got %v and %v", lo.name, hi.name)
+	}
+}
+
+func TestArgMinMax(t *testing.T) {
+	input := []int{3, 1, 4, 1, 5, 9, 2, 9}
+
+	if e, ok := iter.ArgMin(iter.FromSlice(input)); !ok || e != (iter.Enum[int]{Index: 1, Value: 1}) {
+		t.Errorf("Expected {1 1}, got %v", e)
+	}
+	if e, ok := iter.ArgMax(iter.FromSlice(input)); !ok || e != (iter.Enum[int]{Index: 5, Value: 9}) {
+		t.Errorf("Expected {5 9}, got %v", e)
+	}
+	if _, ok := iter.ArgMin(iter.FromSlice([]int{})); ok {
+		t.Errorf("Expected no result for empty iterator")
+	}
+}
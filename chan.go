@@ -0,0 +1,131 @@
+package iter
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"runtime/debug"
+)
+
+// PanicError is the error reported when an iterator panics
+// while being consumed by a goroutine.
+type PanicError struct {
+	// Value is the value passed to panic.
+	Value any
+	// Stack is the stack trace of the goroutine that panicked.
+	Stack []byte
+}
+
+func (p *PanicError) Error() string {
+	return fmt.Sprintf("iter: panic while iterating: %v", p.Value)
+}
+
+// ChanCtx converts the iterator to an unbuffered channel.
+// See ChanBuffCtx for details.
+func ChanCtx[E any](ctx context.Context, it Iter[E]) (<-chan E, func() error) {
+	return ChanBuffCtx(ctx, it, 0)
+}
+
+// ChanBuffCtx converts the iterator to a buffered channel.
+// Differently from ChanBuff, the goroutine feeding the channel
+// exits as soon as the context is cancelled, so abandoning the
+// channel does not leak it as long as the context is eventually done.
+// When the goroutine exits, the iterator is closed and then the channel.
+//
+// The returned function waits for the goroutine to exit and reports
+// why it terminated: the context error if the context was cancelled,
+// a *PanicError if the iterator panicked, the iterator error if it
+// is a failed TryIter, or nil if the iterator was exhausted.
+func ChanBuffCtx[E any](ctx context.Context, it Iter[E], l int) (<-chan E, func() error) {
+	c := make(chan E, l)
+	done := make(chan struct{})
+	var err error
+	go func() {
+		defer close(done)
+		defer close(c)
+		defer func() {
+			if cerr := Close(it); err == nil {
+				err = cerr
+			}
+		}()
+		defer func() {
+			if r := recover(); r != nil {
+				err = &PanicError{Value: r, Stack: debug.Stack()}
+			}
+		}()
+		for {
+			if err = ctx.Err(); err != nil {
+				return
+			}
+			e, ok := it.Next()
+			if !ok {
+				err = Err(it)
+				return
+			}
+			select {
+			case c <- e:
+			case <-ctx.Done():
+				err = ctx.Err()
+				return
+			}
+		}
+	}()
+	return c, func() error {
+		<-done
+		return err
+	}
+}
+
+// FromChan creates an iterator from a channel.
+// The iterator is exhausted when the channel is closed.
+func FromChan[E any](c <-chan E) Iter[E] {
+	return IterFunc[E](func() (E, bool) {
+		e, ok := <-c
+		return e, ok
+	})
+}
+
+// FromChanCtx creates an iterator from a channel, that is exhausted
+// when either the channel is closed or the context is cancelled.
+// In the latter case, Err returns the context error.
+func FromChanCtx[E any](ctx context.Context, c <-chan E) TryIter[E] {
+	var err error
+	return &tryIter[E]{
+		next: func() (E, bool) {
+			if err != nil {
+				return zero[E](), false
+			}
+			select {
+			case e, ok := <-c:
+				return e, ok
+			case <-ctx.Done():
+				err = ctx.Err()
+				return zero[E](), false
+			}
+		},
+		err: func() error { return err },
+	}
+}
+
+// MergeChans creates an iterator merging the elements of the given channels,
+// in the order in which they become ready. The iterator is exhausted
+// when all the channels are closed.
+func MergeChans[E any](chans ...<-chan E) Iter[E] {
+	cases := make([]reflect.SelectCase, len(chans))
+	for i, c := range chans {
+		cases[i] = reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(c)}
+	}
+	return IterFunc[E](func() (E, bool) {
+		for len(cases) > 0 {
+			i, v, ok := reflect.Select(cases)
+			if !ok {
+				cases = append(cases[:i], cases[i+1:]...)
+				continue
+			}
+			// a nil interface value cannot be asserted to E
+			e, _ := v.Interface().(E)
+			return e, true
+		}
+		return zero[E](), false
+	})
+}
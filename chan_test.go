@@ -0,0 +1,166 @@
+package iter_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/gmgigi96/iter"
+)
+
+func TestChanBuffCtx(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []int
+		buffSize int
+		expected []int
+	}{
+		{"ChanBuffCtx with multiple elements and buffer size 3", []int{1, 2, 3, 4, 5}, 3, []int{1, 2, 3, 4, 5}},
+		{"ChanBuffCtx with empty slice", []int{}, 3, []int{}},
+		{"ChanBuffCtx with buffer size 0", []int{1, 2, 3}, 0, []int{1, 2, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ch, wait := iter.ChanBuffCtx(context.Background(), iter.FromSlice(tt.input), tt.buffSize)
+			result := []int{}
+			for val := range ch {
+				result = append(result, val)
+			}
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+			if err := wait(); err != nil {
+				t.Errorf("Unexpected error %v", err)
+			}
+		})
+	}
+}
+
+func TestChanCtxCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	src := &tracker{Iter: iter.Count(0, 1)}
+	ch, wait := iter.ChanCtx(ctx, src)
+	for e := range ch {
+		if e == 3 {
+			break
+		}
+	}
+	cancel()
+	if err := wait(); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected error %v, got %v", context.Canceled, err)
+	}
+	if src.closed != 1 {
+		t.Errorf("Expected upstream to be closed once, got %d", src.closed)
+	}
+	for range ch {
+	}
+}
+
+func TestChanCtxPanic(t *testing.T) {
+	it := iter.Map(iter.Range(5), func(e int) int {
+		if e == 2 {
+			panic("boom")
+		}
+		return e
+	})
+	ch, wait := iter.ChanCtx(context.Background(), it)
+	result := []int{}
+	for val := range ch {
+		result = append(result, val)
+	}
+	if !reflect.DeepEqual(result, []int{0, 1}) {
+		t.Errorf("Expected %v, got %v", []int{0, 1}, result)
+	}
+	var perr *iter.PanicError
+	if err := wait(); !errors.As(err, &perr) || perr.Value != "boom" {
+		t.Errorf("Expected panic error, got %v", err)
+	}
+}
+
+func TestChanCtxTryIter(t *testing.T) {
+	ch, wait := iter.ChanCtx[int](context.Background(), failing([]int{1}))
+	for range ch {
+	}
+	if err := wait(); !errors.Is(err, errTest) {
+		t.Errorf("Expected error %v, got %v", errTest, err)
+	}
+}
+
+func TestFromChan(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []int
+		expected []int
+	}{
+		{"FromChan with multiple elements", []int{1, 2, 3}, []int{1, 2, 3}},
+		{"FromChan with closed channel", []int{}, []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := iter.Slice(iter.FromChan(iter.Chan(iter.FromSlice(tt.input))))
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestFromChanCtx(t *testing.T) {
+	c := make(chan int, 2)
+	c <- 1
+	c <- 2
+	ctx, cancel := context.WithCancel(context.Background())
+	it := iter.FromChanCtx(ctx, c)
+	for i := 1; i <= 2; i++ {
+		if e, ok := it.Next(); !ok || e != i {
+			t.Fatalf("Expected %d, got %v", i, e)
+		}
+	}
+	cancel()
+	if _, ok := it.Next(); ok {
+		t.Errorf("Expected iterator to be exhausted after cancellation")
+	}
+	if err := it.Err(); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected error %v, got %v", context.Canceled, err)
+	}
+}
+
+func TestMergeChans(t *testing.T) {
+	tests := []struct {
+		name     string
+		inputs   [][]int
+		expected []int
+	}{
+		{"MergeChans with multiple channels", [][]int{{1, 2}, {3}, {4, 5, 6}}, []int{1, 2, 3, 4, 5, 6}},
+		{"MergeChans with empty channels", [][]int{{}, {1}, {}}, []int{1}},
+		{"MergeChans without channels", [][]int{}, []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chans := make([]<-chan int, len(tt.inputs))
+			for i, in := range tt.inputs {
+				chans[i] = iter.Chan(iter.FromSlice(in))
+			}
+			result := iter.Slice(iter.MergeChans(chans...))
+			sort.Ints(result)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestMergeChansNilInterface(t *testing.T) {
+	c := make(chan error, 1)
+	c <- nil
+	close(c)
+	result := iter.Slice(iter.MergeChans[error](c))
+	if len(result) != 1 || result[0] != nil {
+		t.Errorf("Expected [<nil>], got %v", result)
+	}
+}
@@ -0,0 +1,189 @@
+package iter
+
+import "strings"
+
+// Collector describes how to collect the elements of an iterator into a result,
+// in the style of Java's Collectors. Collectors can be composed, for example
+// with Mapping, and reused: each collection starts from a new Accumulation.
+type Collector[E, R any] interface {
+	// Supply returns a new, empty accumulation.
+	Supply() Accumulation[E, R]
+}
+
+// Accumulation is an in-progress collection of elements started by a Collector.
+type Accumulation[E, R any] interface {
+	// Accumulate adds the element to the accumulation.
+	Accumulate(E)
+	// Finish returns the result of the accumulation.
+	Finish() R
+}
+
+// collector is a Collector backed by functions, accumulating
+// the elements into an intermediate value of type A.
+type collector[E, A, R any] struct {
+	supply     func() A
+	accumulate func(A, E) A
+	finish     func(A) R
+}
+
+func (c collector[E, A, R]) Supply() Accumulation[E, R] {
+	return &accumulation[E, A, R]{c: c, acc: c.supply()}
+}
+
+type accumulation[E, A, R any] struct {
+	c   collector[E, A, R]
+	acc A
+}
+
+func (a *accumulation[E, A, R]) Accumulate(e E) {
+	a.acc = a.c.accumulate(a.acc, e)
+}
+
+func (a *accumulation[E, A, R]) Finish() R {
+	return a.c.finish(a.acc)
+}
+
+// NewCollector creates a Collector from a function supplying the intermediate
+// value, a function accumulating an element into it, and a function turning
+// it into the result.
+func NewCollector[E, A, R any](supply func() A, accumulate func(A, E) A, finish func(A) R) Collector[E, R] {
+	return collector[E, A, R]{supply: supply, accumulate: accumulate, finish: finish}
+}
+
+func identity[E any](e E) E {
+	return e
+}
+
+// Collect collects the elements of the iterator using the collector.
+func Collect[E, R any](it Iter[E], c Collector[E, R]) R {
+	acc := c.Supply()
+	ForEach(it, acc.Accumulate)
+	return acc.Finish()
+}
+
+// ToSlice returns a Collector collecting the elements into a slice.
+func ToSlice[E any]() Collector[E, []E] {
+	return NewCollector(
+		func() []E { return make([]E, 0) },
+		func(s []E, e E) []E { return append(s, e) },
+		identity[[]E],
+	)
+}
+
+// ToMap returns a Collector collecting map entries into a map.
+// When two entries have the same key, their values are combined
+// using the merge function, or the last one wins if merge is nil.
+func ToMap[K comparable, V any](merge func(old, new V) V) Collector[MapEntry[K, V], map[K]V] {
+	return NewCollector(
+		func() map[K]V { return make(map[K]V) },
+		func(m map[K]V, e MapEntry[K, V]) map[K]V {
+			if old, ok := m[e.Key]; ok && merge != nil {
+				m[e.Key] = merge(old, e.Value)
+			} else {
+				m[e.Key] = e.Value
+			}
+			return m
+		},
+		identity[map[K]V],
+	)
+}
+
+// ToMultiMap returns a Collector collecting map entries into a map
+// of slices, holding all the values of each key in order.
+func ToMultiMap[K comparable, V any]() Collector[MapEntry[K, V], map[K][]V] {
+	return NewCollector(
+		func() map[K][]V { return make(map[K][]V) },
+		func(m map[K][]V, e MapEntry[K, V]) map[K][]V {
+			m[e.Key] = append(m[e.Key], e.Value)
+			return m
+		},
+		identity[map[K][]V],
+	)
+}
+
+// ToSet returns a Collector collecting the distinct elements into a set.
+func ToSet[E comparable]() Collector[E, map[E]struct{}] {
+	return NewCollector(
+		func() map[E]struct{} { return make(map[E]struct{}) },
+		func(m map[E]struct{}, e E) map[E]struct{} {
+			m[e] = struct{}{}
+			return m
+		},
+		identity[map[E]struct{}],
+	)
+}
+
+// Partition returns a Collector splitting the elements into
+// the ones for which the provided function returns true,
+// and the ones for which it returns false.
+func Partition[E any](pred func(E) bool) Collector[E, Pair[[]E, []E]] {
+	return NewCollector(
+		func() Pair[[]E, []E] { return Pair[[]E, []E]{First: make([]E, 0), Second: make([]E, 0)} },
+		func(p Pair[[]E, []E], e E) Pair[[]E, []E] {
+			if pred(e) {
+				p.First = append(p.First, e)
+			} else {
+				p.Second = append(p.Second, e)
+			}
+			return p
+		},
+		identity[Pair[[]E, []E]],
+	)
+}
+
+// JoinStrings returns a Collector concatenating the strings, separated by sep.
+func JoinStrings(sep string) Collector[string, string] {
+	type join struct {
+		b     strings.Builder
+		empty bool
+	}
+	return NewCollector(
+		func() *join { return &join{empty: true} },
+		func(j *join, s string) *join {
+			if !j.empty {
+				j.b.WriteString(sep)
+			}
+			j.b.WriteString(s)
+			j.empty = false
+			return j
+		},
+		func(j *join) string { return j.b.String() },
+	)
+}
+
+// Counting returns a Collector counting the elements.
+func Counting[E any]() Collector[E, int] {
+	return NewCollector(
+		func() int { return 0 },
+		func(n int, _ E) int { return n + 1 },
+		identity[int],
+	)
+}
+
+// Mapping returns a Collector mapping the elements based on
+// the provided function before collecting them with c.
+func Mapping[E, T, R any](f func(E) T, c Collector[T, R]) Collector[E, R] {
+	return NewCollector(
+		c.Supply,
+		func(acc Accumulation[T, R], e E) Accumulation[T, R] {
+			acc.Accumulate(f(e))
+			return acc
+		},
+		Accumulation[T, R].Finish,
+	)
+}
+
+// Filtering returns a Collector collecting with c only the
+// elements for which the provided function returns true.
+func Filtering[E, R any](pred func(E) bool, c Collector[E, R]) Collector[E, R] {
+	return NewCollector(
+		c.Supply,
+		func(acc Accumulation[E, R], e E) Accumulation[E, R] {
+			if pred(e) {
+				acc.Accumulate(e)
+			}
+			return acc
+		},
+		Accumulation[E, R].Finish,
+	)
+}
@@ -0,0 +1,135 @@
+package iter_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gmgigi96/iter"
+)
+
+func TestCollectToSlice(t *testing.T) {
+	result := iter.Collect(iter.Range(3), iter.ToSlice[int]())
+	if !reflect.DeepEqual(result, []int{0, 1, 2}) {
+		t.Errorf("Expected %v, got %v", []int{0, 1, 2}, result)
+	}
+}
+
+func TestCollectToMap(t *testing.T) {
+	entries := []iter.MapEntry[string, int]{{"a", 1}, {"b", 2}, {"a", 3}}
+
+	tests := []struct {
+		name     string
+		merge    func(int, int) int
+		expected map[string]int
+	}{
+		{"ToMap with last value winning", nil, map[string]int{"a": 3, "b": 2}},
+		{"ToMap merging values", func(a, b int) int { return a + b }, map[string]int{"a": 4, "b": 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := iter.Collect(iter.FromSlice(entries), iter.ToMap[string](tt.merge))
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestCollectToMapRoundTrip(t *testing.T) {
+	m := map[int]string{1: "a", 2: "b"}
+	result := iter.Collect(iter.FromMap(m), iter.ToMap[int, string](nil))
+	if !reflect.DeepEqual(result, m) {
+		t.Errorf("Expected %v, got %v", m, result)
+	}
+}
+
+func TestCollectToMultiMap(t *testing.T) {
+	entries := []iter.MapEntry[string, int]{{"a", 1}, {"b", 2}, {"a", 3}}
+	result := iter.Collect(iter.FromSlice(entries), iter.ToMultiMap[string, int]())
+	expected := map[string][]int{"a": {1, 3}, "b": {2}}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
+
+func TestCollectToSet(t *testing.T) {
+	result := iter.Collect(iter.FromSlice([]int{1, 2, 1, 3}), iter.ToSet[int]())
+	expected := map[int]struct{}{1: {}, 2: {}, 3: {}}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
+
+func TestCollectPartition(t *testing.T) {
+	result := iter.Collect(iter.Range(6), iter.Partition(func(e int) bool { return e%2 == 0 }))
+	expected := iter.Pair[[]int, []int]{First: []int{0, 2, 4}, Second: []int{1, 3, 5}}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
+
+func TestCollectJoinStrings(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []string
+		expected string
+	}{
+		{"JoinStrings multiple strings", []string{"a", "b", "c"}, "a, b, c"},
+		{"JoinStrings with empty strings", []string{"", "b", ""}, ", b, "},
+		{"JoinStrings empty slice", []string{}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := iter.Collect(iter.FromSlice(tt.input), iter.JoinStrings(", "))
+			if result != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestCollectCounting(t *testing.T) {
+	if n := iter.Collect(iter.Range(4), iter.Counting[int]()); n != 4 {
+		t.Errorf("Expected 4, got %v", n)
+	}
+}
+
+func TestCollectComposition(t *testing.T) {
+	words := []string{"Go", "go", "Rust", "C"}
+
+	set := iter.Collect(iter.FromSlice(words), iter.Mapping(strings.ToLower, iter.ToSet[string]()))
+	expected := map[string]struct{}{"go": {}, "rust": {}, "c": {}}
+	if !reflect.DeepEqual(set, expected) {
+		t.Errorf("Expected %v, got %v", expected, set)
+	}
+
+	long := func(s string) bool { return len(s) > 1 }
+	joined := iter.Collect(iter.FromSlice(words), iter.Filtering(long, iter.JoinStrings("+")))
+	if joined != "Go+go+Rust" {
+		t.Errorf("Expected Go+go+Rust, got %v", joined)
+	}
+}
+
+func TestCollectorReuse(t *testing.T) {
+	c := iter.JoinStrings("-")
+	first := iter.Collect(iter.FromSlice([]string{"a", "b"}), c)
+	second := iter.Collect(iter.FromSlice([]string{"c"}), c)
+	if first != "a-b" || second != "c" {
+		t.Errorf("Expected a-b and c, got %v and %v", first, second)
+	}
+}
+
+func TestNewCollector(t *testing.T) {
+	type stats struct{ n, sum int }
+	c := iter.NewCollector(
+		func() stats { return stats{} },
+		func(s stats, e int) stats { return stats{s.n + 1, s.sum + e} },
+		func(s stats) float64 { return float64(s.sum) / float64(s.n) },
+	)
+	if mean := iter.Collect(iter.FromSlice([]int{1, 2, 3, 4}), c); mean != 2.5 {
+		t.Errorf("Expected 2.5, got %v", mean)
+	}
+}
@@ -0,0 +1,241 @@
+package iter
+
+// pick returns a new slice with the elements of the pool at the given indices.
+func pick[E any](pool []E, indices []int) []E {
+	res := make([]E, len(indices))
+	for i, ix := range indices {
+		res[i] = pool[ix]
+	}
+	return res
+}
+
+// buffered returns an iterator that, on the first call to Next,
+// reads all the elements of it in a slice and then yields the
+// elements of the iterator returned by f for that slice.
+func buffered[E, T any](it Iter[E], f func([]E) Iter[T]) Iter[T] {
+	var res Iter[T]
+	return withClose(func() (T, bool) {
+		if res == nil {
+			res = f(Slice(it))
+		}
+		return res.Next()
+	}, it)
+}
+
+// Product returns the cartesian product of the elements of the iterators,
+// in the same order as nested for loops with the rightmost iterator in the
+// innermost loop. The iterators are read in full on the first call to Next.
+func Product[E any](its ...Iter[E]) Iter[[]E] {
+	upstream := anys(its)
+	var res Iter[[]E]
+	return withClose(func() ([]E, bool) {
+		if res == nil {
+			pools := make([][]E, len(its))
+			for i, it := range its {
+				pools[i] = Slice(it)
+			}
+			res = ProductSlices(pools...)
+		}
+		return res.Next()
+	}, upstream...)
+}
+
+// ProductSlices returns the cartesian product of the elements of the slices.
+// See Product for details.
+func ProductSlices[E any](pools ...[]E) Iter[[]E] {
+	first, done := true, false
+	indices := make([]int, len(pools))
+	for _, p := range pools {
+		if len(p) == 0 {
+			done = true
+		}
+	}
+	return IterFunc[[]E](func() ([]E, bool) {
+		if done {
+			return nil, false
+		}
+		if !first {
+			i := len(pools) - 1
+			for ; i >= 0; i-- {
+				indices[i]++
+				if indices[i] < len(pools[i]) {
+					break
+				}
+				indices[i] = 0
+			}
+			if i < 0 {
+				done = true
+				return nil, false
+			}
+		}
+		first = false
+		res := make([]E, len(pools))
+		for i, ix := range indices {
+			res[i] = pools[i][ix]
+		}
+		return res, true
+	})
+}
+
+// Permutations returns the permutations of length r of the elements
+// of the slice, in lexicographic order of their positions.
+func Permutations[E any](s []E, r int) Iter[[]E] {
+	if r < 0 {
+		panic("r cannot be negative")
+	}
+	n := len(s)
+	if r > n {
+		return FromSlice[[]E](nil)
+	}
+	indices := make([]int, n)
+	for i := range indices {
+		indices[i] = i
+	}
+	cycles := make([]int, r)
+	for i := range cycles {
+		cycles[i] = n - i
+	}
+	first, done := true, false
+	return IterFunc[[]E](func() ([]E, bool) {
+		if done {
+			return nil, false
+		}
+		if first {
+			first = false
+			return pick(s, indices[:r]), true
+		}
+		for i := r - 1; i >= 0; i-- {
+			cycles[i]--
+			if cycles[i] == 0 {
+				// move the element at i to the end
+				e := indices[i]
+				copy(indices[i:], indices[i+1:])
+				indices[n-1] = e
+				cycles[i] = n - i
+				continue
+			}
+			j := n - cycles[i]
+			indices[i], indices[j] = indices[j], indices[i]
+			return pick(s, indices[:r]), true
+		}
+		done = true
+		return nil, false
+	})
+}
+
+// PermutationsIter is like Permutations, but reads the elements
+// from the iterator, in full on the first call to Next.
+func PermutationsIter[E any](it Iter[E], r int) Iter[[]E] {
+	if r < 0 {
+		panic("r cannot be negative")
+	}
+	return buffered(it, func(s []E) Iter[[]E] { return Permutations(s, r) })
+}
+
+// Combinations returns the combinations of length r of the elements
+// of the slice, in lexicographic order of their positions.
+func Combinations[E any](s []E, r int) Iter[[]E] {
+	if r < 0 {
+		panic("r cannot be negative")
+	}
+	n := len(s)
+	if r > n {
+		return FromSlice[[]E](nil)
+	}
+	indices := make([]int, r)
+	for i := range indices {
+		indices[i] = i
+	}
+	first, done := true, false
+	return IterFunc[[]E](func() ([]E, bool) {
+		if done {
+			return nil, false
+		}
+		if first {
+			first = false
+			return pick(s, indices), true
+		}
+		i := r - 1
+		for ; i >= 0 && indices[i] == i+n-r; i-- {
+		}
+		if i < 0 {
+			done = true
+			return nil, false
+		}
+		indices[i]++
+		for j := i + 1; j < r; j++ {
+			indices[j] = indices[j-1] + 1
+		}
+		return pick(s, indices), true
+	})
+}
+
+// CombinationsIter is like Combinations, but reads the elements
+// from the iterator, in full on the first call to Next.
+func CombinationsIter[E any](it Iter[E], r int) Iter[[]E] {
+	if r < 0 {
+		panic("r cannot be negative")
+	}
+	return buffered(it, func(s []E) Iter[[]E] { return Combinations(s, r) })
+}
+
+// CombinationsWithReplacement returns the combinations of length r
+// of the elements of the slice, allowing each element to be repeated,
+// in lexicographic order of their positions.
+func CombinationsWithReplacement[E any](s []E, r int) Iter[[]E] {
+	if r < 0 {
+		panic("r cannot be negative")
+	}
+	n := len(s)
+	if n == 0 && r > 0 {
+		return FromSlice[[]E](nil)
+	}
+	indices := make([]int, r)
+	first, done := true, false
+	return IterFunc[[]E](func() ([]E, bool) {
+		if done {
+			return nil, false
+		}
+		if first {
+			first = false
+			return pick(s, indices), true
+		}
+		i := r - 1
+		for ; i >= 0 && indices[i] == n-1; i-- {
+		}
+		if i < 0 {
+			done = true
+			return nil, false
+		}
+		v := indices[i] + 1
+		for j := i; j < r; j++ {
+			indices[j] = v
+		}
+		return pick(s, indices), true
+	})
+}
+
+// CombinationsWithReplacementIter is like CombinationsWithReplacement,
+// but reads the elements from the iterator, in full on the first call to Next.
+func CombinationsWithReplacementIter[E any](it Iter[E], r int) Iter[[]E] {
+	if r < 0 {
+		panic("r cannot be negative")
+	}
+	return buffered(it, func(s []E) Iter[[]E] { return CombinationsWithReplacement(s, r) })
+}
+
+// Powerset returns all the subsets of the elements of the slice,
+// ordered by size and then as in Combinations.
+func Powerset[E any](s []E) Iter[[]E] {
+	its := make([]Iter[[]E], len(s)+1)
+	for r := range its {
+		its[r] = Combinations(s, r)
+	}
+	return Chain(its...)
+}
+
+// PowersetIter is like Powerset, but reads the elements
+// from the iterator, in full on the first call to Next.
+func PowersetIter[E any](it Iter[E]) Iter[[]E] {
+	return buffered(it, Powerset[E])
+}
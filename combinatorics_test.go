@@ -0,0 +1,147 @@
+package iter_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gmgigi96/iter"
+)
+
+func TestProduct(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    [][]int
+		expected [][]int
+	}{
+		{"Product of two slices", [][]int{{1, 2}, {3, 4}}, [][]int{{1, 3}, {1, 4}, {2, 3}, {2, 4}}},
+		{"Product of three slices", [][]int{{1}, {2, 3}, {4}}, [][]int{{1, 2, 4}, {1, 3, 4}}},
+		{"Product with an empty slice", [][]int{{1, 2}, {}}, [][]int{}},
+		{"Product of nothing", [][]int{}, [][]int{{}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := iter.Slice(iter.ProductSlices(tt.input...))
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+
+			its := make([]iter.Iter[int], len(tt.input))
+			for i, s := range tt.input {
+				its[i] = iter.FromSlice(s)
+			}
+			result = iter.Slice(iter.Product(its...))
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestPermutations(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		r        int
+		expected []string
+	}{
+		{"Permutations of length 2", "ABC", 2, []string{"AB", "AC", "BA", "BC", "CA", "CB"}},
+		{"Permutations of full length", "ABC", 3, []string{"ABC", "ACB", "BAC", "BCA", "CAB", "CBA"}},
+		{"Permutations of length 0", "AB", 0, []string{""}},
+		{"Permutations longer than input", "AB", 3, []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := iter.Slice(iter.Map(iter.Permutations([]rune(tt.input), tt.r), toString))
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+			result = iter.Slice(iter.Map(iter.PermutationsIter(iter.FromSlice([]rune(tt.input)), tt.r), toString))
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestCombinations(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		r        int
+		expected []string
+	}{
+		{"Combinations of length 2", "ABCD", 2, []string{"AB", "AC", "AD", "BC", "BD", "CD"}},
+		{"Combinations of full length", "ABC", 3, []string{"ABC"}},
+		{"Combinations of length 0", "ABC", 0, []string{""}},
+		{"Combinations longer than input", "AB", 3, []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := iter.Slice(iter.Map(iter.Combinations([]rune(tt.input), tt.r), toString))
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+			result = iter.Slice(iter.Map(iter.CombinationsIter(iter.FromSlice([]rune(tt.input)), tt.r), toString))
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestCombinationsWithReplacement(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		r        int
+		expected []string
+	}{
+		{"CombinationsWithReplacement of length 2", "ABC", 2, []string{"AA", "AB", "AC", "BB", "BC", "CC"}},
+		{"CombinationsWithReplacement longer than input", "AB", 3, []string{"AAA", "AAB", "ABB", "BBB"}},
+		{"CombinationsWithReplacement from empty input", "", 2, []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := iter.Slice(iter.Map(iter.CombinationsWithReplacement([]rune(tt.input), tt.r), toString))
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+			result = iter.Slice(iter.Map(iter.CombinationsWithReplacementIter(iter.FromSlice([]rune(tt.input)), tt.r), toString))
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestPowerset(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected []string
+	}{
+		{"Powerset of three elements", "ABC", []string{"", "A", "B", "C", "AB", "AC", "BC", "ABC"}},
+		{"Powerset of empty input", "", []string{""}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := iter.Slice(iter.Map(iter.Powerset([]rune(tt.input)), toString))
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+			result = iter.Slice(iter.Map(iter.PowersetIter(iter.FromSlice([]rune(tt.input))), toString))
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func toString(r []rune) string {
+	return string(r)
+}
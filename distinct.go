@@ -0,0 +1,66 @@
+package iter
+
+import "container/list"
+
+// Distinct filters out the elements of the iterator already seen.
+// All the distinct elements are kept in memory.
+func Distinct[E comparable](it Iter[E]) Iter[E] {
+	return DistinctBy(it, func(e E) E { return e })
+}
+
+// DistinctBy filters out the elements of the iterator whose key,
+// computed by the provided function, was already seen.
+// All the distinct keys are kept in memory.
+func DistinctBy[E any, K comparable](it Iter[E], key func(E) K) Iter[E] {
+	seen := make(map[K]struct{})
+	return Filter(it, func(e E) bool {
+		k := key(e)
+		if _, ok := seen[k]; ok {
+			return false
+		}
+		seen[k] = struct{}{}
+		return true
+	})
+}
+
+// Compact collapses runs of consecutive equal elements into a single one.
+func Compact[E comparable](it Iter[E]) Iter[E] {
+	return CompactFunc(it, func(a, b E) bool { return a == b })
+}
+
+// CompactFunc is like Compact, but uses the provided
+// function to compare consecutive elements.
+func CompactFunc[E any](it Iter[E], eq func(a, b E) bool) Iter[E] {
+	var prev E
+	var started bool
+	return Filter(it, func(e E) bool {
+		if started && eq(prev, e) {
+			return false
+		}
+		prev, started = e, true
+		return true
+	})
+}
+
+// DistinctWindow filters out the elements of the iterator among the
+// last n distinct elements seen, evicting the least recently seen one
+// when more are seen. Memory is bounded by n, at the cost of letting
+// duplicates through when they are further apart.
+func DistinctWindow[E comparable](it Iter[E], n int) Iter[E] {
+	if n <= 0 {
+		panic("size must be positive")
+	}
+	lru := list.New()
+	seen := make(map[E]*list.Element, n)
+	return Filter(it, func(e E) bool {
+		if el, ok := seen[e]; ok {
+			lru.MoveToFront(el)
+			return false
+		}
+		seen[e] = lru.PushFront(e)
+		if lru.Len() > n {
+			delete(seen, lru.Remove(lru.Back()).(E))
+		}
+		return true
+	})
+}
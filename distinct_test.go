@@ -0,0 +1,91 @@
+package iter_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gmgigi96/iter"
+)
+
+func TestDistinct(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []int
+		expected []int
+	}{
+		{"Distinct with duplicates", []int{1, 2, 1, 3, 2, 4}, []int{1, 2, 3, 4}},
+		{"Distinct without duplicates", []int{1, 2, 3}, []int{1, 2, 3}},
+		{"Distinct from empty slice", []int{}, []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := iter.Slice(iter.Distinct(iter.FromSlice(tt.input)))
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestDistinctBy(t *testing.T) {
+	input := []string{"Go", "go", "Rust", "GO", "rust", "C"}
+	result := iter.Slice(iter.DistinctBy(iter.FromSlice(input), strings.ToLower))
+	expected := []string{"Go", "Rust", "C"}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
+
+func TestCompact(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []int
+		expected []int
+	}{
+		{"Compact consecutive duplicates", []int{1, 1, 2, 2, 2, 1, 3, 3}, []int{1, 2, 1, 3}},
+		{"Compact without duplicates", []int{1, 2, 3}, []int{1, 2, 3}},
+		{"Compact from empty slice", []int{}, []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := iter.Slice(iter.Compact(iter.FromSlice(tt.input)))
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestCompactFunc(t *testing.T) {
+	input := []string{"a", "A", "b", "B", "b", "a"}
+	result := iter.Slice(iter.CompactFunc(iter.FromSlice(input), strings.EqualFold))
+	expected := []string{"a", "b", "a"}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
+
+func TestDistinctWindow(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []int
+		n        int
+		expected []int
+	}{
+		{"DistinctWindow within window", []int{1, 2, 1, 2, 3}, 2, []int{1, 2, 3}},
+		{"DistinctWindow evicting old keys", []int{1, 2, 3, 1}, 2, []int{1, 2, 3, 1}},
+		{"DistinctWindow refreshing seen keys", []int{1, 2, 1, 3, 1}, 2, []int{1, 2, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := iter.Slice(iter.DistinctWindow(iter.FromSlice(tt.input), tt.n))
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
@@ -9,7 +9,7 @@ func (f IterFunc[E]) Next() (E, bool) {
 
 // Filter filters the elements of the iterator based on the provided function.
 func Filter[E any](it Iter[E], f func(E) bool) Iter[E] {
-	return IterFunc[E](func() (E, bool) {
+	return withClose(func() (E, bool) {
 		for {
 			e, ok := it.Next()
 			if !ok {
@@ -19,18 +19,18 @@ func Filter[E any](it Iter[E], f func(E) bool) Iter[E] {
 				return e, true
 			}
 		}
-	})
+	}, it)
 }
 
 // Map maps the elements of the iterator to another type based on the provided function.
 func Map[E, T any](it Iter[E], f func(E) T) Iter[T] {
-	return IterFunc[T](func() (T, bool) {
+	return withClose(func() (T, bool) {
 		e, ok := it.Next()
 		if !ok {
 			return zero[T](), false
 		}
 		return f(e), true
-	})
+	}, it)
 }
 
 // Range returns an iterator for a range of integers up to the given stop.
@@ -45,18 +45,9 @@ func Range2(start, stop int) Iter[int] {
 
 // Range3 returns an iterator for a range of integers
 // between start and stop with a specified step.
+// See RangeOf for ranges of other integer types.
 func Range3(start, stop, step int) Iter[int] {
-	if step == 0 {
-		panic("step cannot be zero")
-	}
-	return IterFunc[int](func() (int, bool) {
-		if step > 0 && start >= stop || step < 0 && start <= stop {
-			return 0, false
-		}
-		curr := start
-		start += step
-		return curr, true
-	})
+	return RangeOf(start, stop, step)
 }
 
 // Enum represents a value with its index.
@@ -68,7 +59,7 @@ type Enum[E any] struct {
 // Enumerate enumerates the elements of the iterator.
 func Enumerate[E any](it Iter[E]) Iter[Enum[E]] {
 	var i int
-	return IterFunc[Enum[E]](func() (Enum[E], bool) {
+	return withClose(func() (Enum[E], bool) {
 		e, ok := it.Next()
 		if !ok {
 			return zero[Enum[E]](), false
@@ -76,20 +67,25 @@ func Enumerate[E any](it Iter[E]) Iter[Enum[E]] {
 		n := i
 		i++
 		return Enum[E]{Value: e, Index: n}, true
-	})
+	}, it)
 }
 
-// Zip zips two iterators into one.
+// Zip zips two iterators into one, stopping at the shortest one.
+// If it2 is exhausted first, the element read from it1 is lost,
+// unless it1 is a PeekIter: in that case the element is pushed back.
 func Zip[E, T any](it1 Iter[E], it2 Iter[T]) Iter[Pair[E, T]] {
-	return IterFunc[Pair[E, T]](func() (Pair[E, T], bool) {
+	return withClose(func() (Pair[E, T], bool) {
 		first, ok := it1.Next()
 		if !ok {
 			return zero[Pair[E, T]](), false
 		}
 		second, ok := it2.Next()
 		if !ok {
+			if p, ok := it1.(*PeekIter[E]); ok {
+				p.Unread(first)
+			}
 			return zero[Pair[E, T]](), false
 		}
 		return Pair[E, T]{First: first, Second: second}, true
-	})
+	}, it1, it2)
 }
@@ -0,0 +1,148 @@
+package iter
+
+// group is the state of a group returned by GroupBy.
+type group[K comparable] struct {
+	key K
+	// first reports whether the first element of the group,
+	// which is always part of it, has not been returned yet
+	first bool
+	done  bool
+}
+
+// GroupBy groups runs of consecutive elements of the iterator sharing
+// the same key. Each group is returned as a pair of the key and an
+// iterator lazily yielding the elements of the run.
+// Advancing the outer iterator skips the remaining elements of the
+// current group, whose iterator is then exhausted.
+func GroupBy[E any, K comparable](it Iter[E], key func(E) K) Iter[Pair[K, Iter[E]]] {
+	// e is the element read from the upstream iterator
+	// but not returned yet, and k its key
+	var (
+		e       E
+		k       K
+		has     bool
+		started bool
+		curr    *group[K]
+	)
+	fetch := func() {
+		e, has = it.Next()
+		if has {
+			k = key(e)
+		}
+	}
+	return withClose(func() (Pair[K, Iter[E]], bool) {
+		if !started {
+			started = true
+			fetch()
+		}
+		if curr != nil {
+			if curr.first && !curr.done {
+				fetch()
+			}
+			for has && k == curr.key && !curr.done {
+				fetch()
+			}
+			curr.done = true
+		}
+		if !has {
+			return zero[Pair[K, Iter[E]]](), false
+		}
+		g := &group[K]{key: k, first: true}
+		curr = g
+		return Pair[K, Iter[E]]{
+			First: k,
+			Second: IterFunc[E](func() (E, bool) {
+				// the key is not compared for the first element,
+				// as it may not be equal to itself (e.g. NaN)
+				if g.done || !g.first && (!has || k != g.key) {
+					g.done = true
+					return zero[E](), false
+				}
+				g.first = false
+				res := e
+				fetch()
+				return res, true
+			}),
+		}, true
+	}, it)
+}
+
+// GroupBySlice is like GroupBy, but returns
+// the elements of each group in a slice.
+func GroupBySlice[E any, K comparable](it Iter[E], key func(E) K) Iter[Pair[K, []E]] {
+	return Map(GroupBy(it, key), func(p Pair[K, Iter[E]]) Pair[K, []E] {
+		return Pair[K, []E]{First: p.First, Second: Slice(p.Second)}
+	})
+}
+
+// GroupInto groups the elements of the iterator by key,
+// regardless of their position, into a map of slices.
+func GroupInto[E any, K comparable](it Iter[E], key func(E) K) map[K][]E {
+	return GroupAggregate(it, key, func() []E { return nil }, func(s []E, e E) []E { return append(s, e) })
+}
+
+// GroupAggregate groups the elements of the iterator by key, regardless
+// of their position, aggregating the elements of each group: the aggregate
+// of a group starts from the value returned by init, and each element is
+// added to it using add.
+func GroupAggregate[E any, K comparable, A any](it Iter[E], key func(E) K, init func() A, add func(A, E) A) map[K]A {
+	m := make(map[K]A)
+	for e, ok := it.Next(); ok; e, ok = it.Next() {
+		k := key(e)
+		a, ok := m[k]
+		if !ok {
+			a = init()
+		}
+		m[k] = add(a, e)
+	}
+	return m
+}
+
+// GroupAggregateOrdered is like GroupAggregate, but returns an iterator
+// of the groups in the order in which their keys were first seen.
+// The upstream iterator is consumed in full on the first call to Next.
+func GroupAggregateOrdered[E any, K comparable, A any](it Iter[E], key func(E) K, init func() A, add func(A, E) A) Iter[Pair[K, A]] {
+	var groups Iter[Pair[K, A]]
+	return withClose(func() (Pair[K, A], bool) {
+		if groups == nil {
+			var keys []K
+			m := make(map[K]A)
+			for e, ok := it.Next(); ok; e, ok = it.Next() {
+				k := key(e)
+				a, ok := m[k]
+				if !ok {
+					a = init()
+					keys = append(keys, k)
+				}
+				m[k] = add(a, e)
+			}
+			groups = Map(FromSlice(keys), func(k K) Pair[K, A] { return Pair[K, A]{First: k, Second: m[k]} })
+		}
+		return groups.Next()
+	}, it)
+}
+
+// GroupingBy returns a Collector grouping the elements by key,
+// and collecting the elements of each group with downstream.
+func GroupingBy[E any, K comparable, R any](key func(E) K, downstream Collector[E, R]) Collector[E, map[K]R] {
+	return NewCollector(
+		func() map[K]Accumulation[E, R] { return make(map[K]Accumulation[E, R]) },
+		func(m map[K]Accumulation[E, R], e E) map[K]Accumulation[E, R] {
+			k := key(e)
+			acc, ok := m[k]
+			if !ok {
+				acc = downstream.Supply()
+				m[k] = acc
+			}
+			acc.Accumulate(e)
+			return m
+		},
+		func(m map[K]Accumulation[E, R]) map[K]R {
+			res := make(map[K]R, len(m))
+			for k, acc := range m {
+				res[k] = acc.Finish()
+			}
+			return res
+		},
+	)
+}
@@ -0,0 +1,140 @@
+package iter_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/gmgigi96/iter"
+)
+
+func TestGroupBy(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected []iter.Pair[rune, []rune]
+	}{
+		{"GroupBy runs of letters", "aaabbca", []iter.Pair[rune, []rune]{{'a', []rune("aaa")}, {'b', []rune("bb")}, {'c', []rune("c")}, {'a', []rune("a")}}},
+		{"GroupBy single run", "xxx", []iter.Pair[rune, []rune]{{'x', []rune("xxx")}}},
+		{"GroupBy from empty input", "", []iter.Pair[rune, []rune]{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id := func(r rune) rune { return r }
+			result := iter.Slice(iter.GroupBySlice(iter.FromSlice([]rune(tt.input)), id))
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+
+	t.Run("GroupBy with NaN keys", func(t *testing.T) {
+		// NaN is not equal to itself, so each NaN is a group on its own
+		id := func(e float64) float64 { return e }
+		input := []float64{math.NaN(), math.NaN(), 1, 1}
+
+		var sizes []int
+		for _, g := range iter.Slice(iter.GroupBySlice(iter.FromSlice(input), id)) {
+			sizes = append(sizes, len(g.Second))
+		}
+		if expected := []int{1, 1, 2}; !reflect.DeepEqual(sizes, expected) {
+			t.Errorf("Expected %v, got %v", expected, sizes)
+		}
+
+		if n := iter.Len(iter.GroupBy(iter.FromSlice(input), id)); n != 3 {
+			t.Errorf("Expected 3 groups, got %v", n)
+		}
+	})
+}
+
+func TestGroupBySkipsUnfinishedGroups(t *testing.T) {
+	tests := []struct {
+		name     string
+		read     int
+		expected [][]int
+	}{
+		{"GroupBy without reading groups", 0, [][]int{{}, {}, {}, {}}},
+		{"GroupBy reading the first element of each group", 1, [][]int{{1}, {3}, {7}, {9}}},
+		{"GroupBy reading more than available", 5, [][]int{{1, 2}, {3, 4, 5}, {7}, {9}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			it := iter.GroupBy(iter.FromSlice([]int{1, 2, 3, 4, 5, 7, 9}), func(e int) int { return e / 3 })
+			var groups []iter.Iter[int]
+			result := [][]int{}
+			for g, ok := it.Next(); ok; g, ok = it.Next() {
+				groups = append(groups, g.Second)
+				var read []int
+				for i := 0; i < tt.read; i++ {
+					if e, ok := g.Second.Next(); ok {
+						read = append(read, e)
+					}
+				}
+				result = append(result, append([]int{}, read...))
+			}
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+			for _, g := range groups {
+				if _, ok := g.Next(); ok {
+					t.Errorf("Expected skipped group to be exhausted")
+				}
+			}
+		})
+	}
+}
+
+func TestGroupInto(t *testing.T) {
+	result := iter.GroupInto(iter.FromSlice([]string{"apple", "bob", "avocado", "cat", "banana"}), func(s string) byte { return s[0] })
+	expected := map[byte][]string{'a': {"apple", "avocado"}, 'b': {"bob", "banana"}, 'c': {"cat"}}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
+
+func TestGroupAggregate(t *testing.T) {
+	type sale struct {
+		region string
+		amount int
+	}
+	sales := []sale{{"eu", 10}, {"us", 5}, {"eu", 7}, {"asia", 1}, {"us", 3}}
+	region := func(s sale) string { return s.region }
+	sum := func(a int, s sale) int { return a + s.amount }
+	init := func() int { return 0 }
+
+	result := iter.GroupAggregate(iter.FromSlice(sales), region, init, sum)
+	expected := map[string]int{"eu": 17, "us": 8, "asia": 1}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+
+	ordered := iter.Slice(iter.GroupAggregateOrdered(iter.FromSlice(sales), region, init, sum))
+	expectedOrdered := []iter.Pair[string, int]{{"eu", 17}, {"us", 8}, {"asia", 1}}
+	if !reflect.DeepEqual(ordered, expectedOrdered) {
+		t.Errorf("Expected %v, got %v", expectedOrdered, ordered)
+	}
+
+	empty := iter.Slice(iter.GroupAggregateOrdered(iter.FromSlice([]sale{}), region, init, sum))
+	if len(empty) != 0 {
+		t.Errorf("Expected no groups, got %v", empty)
+	}
+}
+
+func TestGroupingBy(t *testing.T) {
+	words := iter.FromSlice([]string{"go", "rust", "c", "java", "zig", "ada"})
+	byLen := func(s string) int { return len(s) }
+
+	result := iter.Collect(words, iter.GroupingBy(byLen, iter.Counting[string]()))
+	expected := map[int]int{1: 1, 2: 1, 3: 2, 4: 2}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+
+	joined := iter.Collect(iter.FromSlice([]string{"go", "zig", "ada"}), iter.GroupingBy(byLen, iter.JoinStrings(",")))
+	expectedJoined := map[int]string{2: "go", 3: "zig,ada"}
+	if !reflect.DeepEqual(joined, expectedJoined) {
+		t.Errorf("Expected %v, got %v", expectedJoined, joined)
+	}
+}
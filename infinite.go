@@ -15,7 +15,7 @@ func Cycle[E any](it Iter[E]) Iter[E] {
 	var exausted bool
 	var el []E
 	var i int
-	return IterFunc[E](func() (E, bool) {
+	return withClose(func() (E, bool) {
 	exausted:
 		if exausted {
 			c := i
@@ -32,7 +32,7 @@ func Cycle[E any](it Iter[E]) Iter[E] {
 			panic("iterable was empty")
 		}
 		goto exausted
-	})
+	}, it)
 }
 
 // Repeat repeats the given element for the specified number of times.
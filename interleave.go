@@ -0,0 +1,75 @@
+package iter
+
+// RoundRobin interleaves the elements of the iterators, taking one
+// element from each of them in turn. Exhausted iterators are dropped
+// and the iteration continues with the remaining ones.
+func RoundRobin[E any](its ...Iter[E]) Iter[E] {
+	weights := make([]int, len(its))
+	for i := range weights {
+		weights[i] = 1
+	}
+	return WeightedRoundRobin(weights, its...)
+}
+
+// WeightedRoundRobin is like RoundRobin, but takes up to weights[i]
+// elements from the i-th iterator on each turn.
+func WeightedRoundRobin[E any](weights []int, its ...Iter[E]) Iter[E] {
+	if len(weights) != len(its) {
+		panic("weights and iterators must have the same length")
+	}
+	type source struct {
+		it     Iter[E]
+		weight int
+	}
+	active := make([]source, len(its))
+	for i, it := range its {
+		if weights[i] <= 0 {
+			panic("weights must be positive")
+		}
+		active[i] = source{it: it, weight: weights[i]}
+	}
+	// i is the current source, and taken the
+	// number of elements read from it in this turn
+	var i, taken int
+	return withClose(func() (E, bool) {
+		for len(active) > 0 {
+			if taken == active[i].weight {
+				i = (i + 1) % len(active)
+				taken = 0
+			}
+			e, ok := active[i].it.Next()
+			if ok {
+				taken++
+				return e, true
+			}
+			active = append(active[:i], active[i+1:]...)
+			taken = 0
+			if i == len(active) {
+				i = 0
+			}
+		}
+		return zero[E](), false
+	}, anys(its)...)
+}
+
+// Intersperse inserts sep between each pair of consecutive elements of the iterator.
+func Intersperse[E any](it Iter[E], sep E) Iter[E] {
+	var pending E
+	var hasPending, started bool
+	return withClose(func() (E, bool) {
+		if hasPending {
+			hasPending = false
+			return pending, true
+		}
+		e, ok := it.Next()
+		if !ok {
+			return zero[E](), false
+		}
+		if !started {
+			started = true
+			return e, true
+		}
+		pending, hasPending = e, true
+		return sep, true
+	}, it)
+}
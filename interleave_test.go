@@ -0,0 +1,80 @@
+package iter_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gmgigi96/iter"
+)
+
+func TestRoundRobin(t *testing.T) {
+	tests := []struct {
+		name     string
+		inputs   []string
+		expected string
+	}{
+		{"RoundRobin same lengths", []string{"AB", "CD", "EF"}, "ACEBDF"},
+		{"RoundRobin different lengths", []string{"ABC", "D", "EF"}, "ADEBFC"},
+		{"RoundRobin with empty inputs", []string{"", "AB", ""}, "AB"},
+		{"RoundRobin without inputs", []string{}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			its := make([]iter.Iter[rune], len(tt.inputs))
+			for i, in := range tt.inputs {
+				its[i] = iter.FromSlice([]rune(in))
+			}
+			result := string(iter.Slice(iter.RoundRobin(its...)))
+			if result != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestWeightedRoundRobin(t *testing.T) {
+	tests := []struct {
+		name     string
+		inputs   []string
+		weights  []int
+		expected string
+	}{
+		{"WeightedRoundRobin 2 and 1", []string{"ABCD", "xyz"}, []int{2, 1}, "ABxCDyz"},
+		{"WeightedRoundRobin with exhausted source mid turn", []string{"A", "xyz", "123"}, []int{3, 1, 2}, "Ax12y3z"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			its := make([]iter.Iter[rune], len(tt.inputs))
+			for i, in := range tt.inputs {
+				its[i] = iter.FromSlice([]rune(in))
+			}
+			result := string(iter.Slice(iter.WeightedRoundRobin(tt.weights, its...)))
+			if result != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestIntersperse(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []int
+		expected []int
+	}{
+		{"Intersperse multiple elements", []int{1, 2, 3}, []int{1, 0, 2, 0, 3}},
+		{"Intersperse single element", []int{1}, []int{1}},
+		{"Intersperse empty slice", []int{}, []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := iter.Slice(iter.Intersperse(iter.FromSlice(tt.input), 0))
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package iter
+
+// Take takes the first n elements of the iterator.
+// It never reads more than n elements from the upstream
+// iterator, which can therefore be used to read the rest.
+func Take[E any](it Iter[E], n int) Iter[E] {
+	return withClose(func() (E, bool) {
+		if n <= 0 {
+			return zero[E](), false
+		}
+		e, ok := it.Next()
+		if !ok {
+			n = 0
+			return zero[E](), false
+		}
+		n--
+		return e, true
+	}, it)
+}
+
+// Skip skips the first n elements of the iterator.
+// The elements are skipped on the first call to Next.
+func Skip[E any](it Iter[E], n int) Iter[E] {
+	return withClose(func() (E, bool) {
+		for ; n > 0; n-- {
+			if _, ok := it.Next(); !ok {
+				n = 0
+				return zero[E](), false
+			}
+		}
+		return it.Next()
+	}, it)
+}
+
+// StepBy returns the first element of the iterator
+// and then every step-th element after it.
+func StepBy[E any](it Iter[E], step int) Iter[E] {
+	if step <= 0 {
+		panic("step must be positive")
+	}
+	var started bool
+	return withClose(func() (E, bool) {
+		if started {
+			for i := 1; i < step; i++ {
+				if _, ok := it.Next(); !ok {
+					return zero[E](), false
+				}
+			}
+		}
+		started = true
+		return it.Next()
+	}, it)
+}
+
+// SliceRange returns the elements of the iterator whose positions are
+// the ones returned by Range3(start, stop, step), as Python's islice.
+// Only non-negative starts and positive steps are allowed, and no
+// element past the last one returned is read from the upstream iterator.
+func SliceRange[E any](it Iter[E], start, stop, step int) Iter[E] {
+	if start < 0 {
+		panic("start cannot be negative")
+	}
+	if step <= 0 {
+		panic("step must be positive")
+	}
+	var n int
+	if stop > start {
+		// avoid overflowing when stop is close to math.MaxInt
+		n = (stop - start) / step
+		if (stop-start)%step != 0 {
+			n++
+		}
+	}
+	return Take(StepBy(Skip(it, start), step), n)
+}
+
+// Nth returns the element of the iterator at position n,
+// consuming all the elements before it.
+func Nth[E any](it Iter[E], n int) (E, bool) {
+	if n < 0 {
+		return zero[E](), false
+	}
+	return Skip(it, n).Next()
+}
+
+// Last returns the last element of the iterator, consuming it.
+func Last[E any](it Iter[E]) (E, bool) {
+	var last E
+	var found bool
+	for e, ok := it.Next(); ok; e, ok = it.Next() {
+		last, found = e, true
+	}
+	return last, found
+}
+
+// Compress returns the elements of data for which the corresponding
+// element of selectors is true, stopping at the shortest of the two.
+func Compress[E any](data Iter[E], selectors Iter[bool]) Iter[E] {
+	return withClose(func() (E, bool) {
+		for {
+			e, ok := data.Next()
+			if !ok {
+				return zero[E](), false
+			}
+			s, ok := selectors.Next()
+			if !ok {
+				return zero[E](), false
+			}
+			if s {
+				return e, true
+			}
+		}
+	}, data, selectors)
+}
@@ -0,0 +1,153 @@
+package iter_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/gmgigi96/iter"
+)
+
+func TestTake(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []int
+		n        int
+		expected []int
+		rest     []int
+	}{
+		{"Take less than available", []int{1, 2, 3, 4}, 2, []int{1, 2}, []int{3, 4}},
+		{"Take more than available", []int{1, 2}, 5, []int{1, 2}, []int{}},
+		{"Take zero elements", []int{1, 2}, 0, []int{}, []int{1, 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			it := iter.FromSlice(tt.input)
+			result := iter.Slice(iter.Take(it, tt.n))
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+			rest := iter.Slice(it)
+			if !reflect.DeepEqual(rest, tt.rest) {
+				t.Errorf("Expected rest %v, got %v", tt.rest, rest)
+			}
+		})
+	}
+}
+
+func TestSkip(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []int
+		n        int
+		expected []int
+	}{
+		{"Skip header", []int{1, 2, 3, 4}, 1, []int{2, 3, 4}},
+		{"Skip more than available", []int{1, 2}, 5, []int{}},
+		{"Skip zero elements", []int{1, 2}, 0, []int{1, 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := iter.Slice(iter.Skip(iter.FromSlice(tt.input), tt.n))
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestStepBy(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []int
+		step     int
+		expected []int
+	}{
+		{"StepBy 2", []int{0, 1, 2, 3, 4}, 2, []int{0, 2, 4}},
+		{"StepBy 3", []int{0, 1, 2, 3, 4}, 3, []int{0, 3}},
+		{"StepBy 1", []int{0, 1, 2}, 1, []int{0, 1, 2}},
+		{"StepBy from empty slice", []int{}, 2, []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := iter.Slice(iter.StepBy(iter.FromSlice(tt.input), tt.step))
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestSliceRange(t *testing.T) {
+	tests := []struct {
+		name              string
+		start, stop, step int
+		// limit is the number of elements to read, or -1 for all
+		limit    int
+		expected []int
+		next     int
+	}{
+		{"SliceRange from 2 to 5", 2, 5, 1, -1, []int{2, 3, 4}, 5},
+		{"SliceRange from 1 to 10 with step 3", 1, 10, 3, -1, []int{1, 4, 7}, 8},
+		{"SliceRange from 0 to 9 with step 3", 0, 9, 3, -1, []int{0, 3, 6}, 7},
+		{"SliceRange with stop before start", 5, 2, 1, -1, []int{}, 0},
+		{"SliceRange up to math.MaxInt with step 2", 0, math.MaxInt, 2, 3, []int{0, 2, 4}, 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			it := iter.Count(0, 1)
+			sliced := iter.SliceRange(it, tt.start, tt.stop, tt.step)
+			if tt.limit >= 0 {
+				sliced = iter.Take(sliced, tt.limit)
+			}
+			result := iter.Slice(sliced)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+			if next, _ := it.Next(); next != tt.next {
+				t.Errorf("Expected upstream to continue from %v, got %v", tt.next, next)
+			}
+		})
+	}
+}
+
+func TestNthAndLast(t *testing.T) {
+	if e, ok := iter.Nth(iter.Range2(10, 20), 3); !ok || e != 13 {
+		t.Errorf("Expected 13, got %v", e)
+	}
+	if _, ok := iter.Nth(iter.Range(3), 3); ok {
+		t.Errorf("Expected no element past the end")
+	}
+	if e, ok := iter.Last(iter.Range(5)); !ok || e != 4 {
+		t.Errorf("Expected 4, got %v", e)
+	}
+	if _, ok := iter.Last(iter.Range(0)); ok {
+		t.Errorf("Expected no last element for empty iterator")
+	}
+}
+
+func TestCompress(t *testing.T) {
+	tests := []struct {
+		name      string
+		data      string
+		selectors []bool
+		expected  string
+	}{
+		{"Compress with selectors", "ABCDEF", []bool{true, false, true, false, true, true}, "ACEF"},
+		{"Compress with shorter selectors", "ABCDEF", []bool{false, true}, "B"},
+		{"Compress with no selector", "ABC", []bool{}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := iter.Slice(iter.Compress(iter.FromSlice([]rune(tt.data)), iter.FromSlice(tt.selectors)))
+			if string(result) != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, string(result))
+			}
+		})
+	}
+}
@@ -1,17 +1,61 @@
 package iter
 
+import "io"
+
 // Iter is an interface representing an iterator.
 type Iter[E any] interface {
 	// Next returns the next element in the iterator.
 	Next() (elem E, ok bool)
 }
 
+// CloseIter is an interface representing an iterator holding resources,
+// such as open files or goroutines, that must be released by calling
+// Close when the iterator is no longer needed.
+type CloseIter[E any] interface {
+	Iter[E]
+	io.Closer
+}
+
+// Close closes the iterator if it is a CloseIter.
+// It is a no-op for any other iterator.
+func Close[E any](it Iter[E]) error {
+	return closeAll(it)
+}
+
+// closeIter is an iterator calling next for each element
+// and close to release its resources.
+type closeIter[E any] struct {
+	next  func() (E, bool)
+	close func() error
+}
+
+func (c *closeIter[E]) Next() (E, bool) {
+	return c.next()
+}
+
+func (c *closeIter[E]) Close() error {
+	return c.close()
+}
+
+// withClose returns an iterator calling next for each element,
+// whose Close method closes the given upstream iterators.
+func withClose[E any](next func() (E, bool), upstream ...any) Iter[E] {
+	return &closeIter[E]{next: next, close: func() error { return closeAll(upstream...) }}
+}
+
 // Pair represents a pair of values.
 type Pair[E, T any] struct {
 	First  E
 	Second T
 }
 
+// Triple represents a triple of values.
+type Triple[E, T, U any] struct {
+	First  E
+	Second T
+	Third  U
+}
+
 // Slice converts the iterator to a slice.
 func Slice[E any](it Iter[E]) []E {
 	s := make([]E, 0)
@@ -22,6 +66,8 @@ func Slice[E any](it Iter[E]) []E {
 }
 
 // Chan converts the iterator to an unbuffered channel.
+// The goroutine feeding the channel blocks forever if the
+// channel is not drained: use ChanCtx to be able to stop it.
 func Chan[E any](it Iter[E]) <-chan E {
 	c := make(chan E)
 	go func() {
@@ -34,6 +80,8 @@ func Chan[E any](it Iter[E]) <-chan E {
 }
 
 // ChanBuff converts the iterator to a buffered channel.
+// The goroutine feeding the channel blocks forever if the
+// channel is not drained: use ChanBuffCtx to be able to stop it.
 func ChanBuff[E any](it Iter[E], l int) <-chan E {
 	c := make(chan E, l)
 	go func() {
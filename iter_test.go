@@ -168,3 +168,65 @@ func TestChanBuff(t *testing.T) {
 		})
 	}
 }
+
+// tracker is an iterator counting how many times it has been closed.
+type tracker struct {
+	iter.Iter[int]
+	closed int
+}
+
+func (t *tracker) Close() error {
+	t.closed++
+	return nil
+}
+
+func TestClose(t *testing.T) {
+	id := func(e int) int { return e }
+	pred := func(e int) bool { return e < 3 }
+
+	tests := []struct {
+		name string
+		fn   func(iter.Iter[int]) iter.Iter[int]
+	}{
+		{"Close Filter", func(it iter.Iter[int]) iter.Iter[int] { return iter.Filter(it, pred) }},
+		{"Close Map", func(it iter.Iter[int]) iter.Iter[int] { return iter.Map(it, id) }},
+		{"Close FilterFalse", func(it iter.Iter[int]) iter.Iter[int] { return iter.FilterFalse(it, pred) }},
+		{"Close DropWhile", func(it iter.Iter[int]) iter.Iter[int] { return iter.DropWhile(it, pred) }},
+		{"Close TakeWhile", func(it iter.Iter[int]) iter.Iter[int] { return iter.TakeWhile(it, pred) }},
+		{"Close Chain", func(it iter.Iter[int]) iter.Iter[int] { return iter.Chain(iter.Range(2), it) }},
+		{"Close Cycle", func(it iter.Iter[int]) iter.Iter[int] { return iter.Cycle(it) }},
+		{"Close Accumulate", func(it iter.Iter[int]) iter.Iter[int] {
+			return iter.Accumulate(it, func(a, b int) int { return a + b }, 0)
+		}},
+		{"Close Enumerate", func(it iter.Iter[int]) iter.Iter[int] {
+			return iter.Map(iter.Enumerate(it), func(e iter.Enum[int]) int { return e.Value })
+		}},
+		{"Close Zip", func(it iter.Iter[int]) iter.Iter[int] {
+			return iter.Map(iter.Zip(iter.Range(2), it), func(p iter.Pair[int, int]) int { return p.Second })
+		}},
+		{"Close TryMap", func(it iter.Iter[int]) iter.Iter[int] { return iter.TryMap(iter.Try(it), id) }},
+		{"Close MapErr", func(it iter.Iter[int]) iter.Iter[int] {
+			return iter.MapErr(it, func(e int) (int, error) { return e, nil })
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			src := &tracker{Iter: iter.Range(5)}
+			it := tt.fn(src)
+			it.Next()
+			if err := iter.Close(it); err != nil {
+				t.Errorf("Unexpected error %v", err)
+			}
+			if src.closed != 1 {
+				t.Errorf("Expected upstream to be closed once, got %d", src.closed)
+			}
+		})
+	}
+}
+
+func TestClosePlainIterator(t *testing.T) {
+	if err := iter.Close(iter.FromSlice([]int{1, 2})); err != nil {
+		t.Errorf("Unexpected error %v", err)
+	}
+}
@@ -1,6 +1,9 @@
 package iter
 
-import "reflect"
+import (
+	"cmp"
+	"slices"
+)
 
 // MapEntry represents a key-value pair from a map.
 type MapEntry[K comparable, V any] struct {
@@ -8,26 +11,93 @@ type MapEntry[K comparable, V any] struct {
 	Value V
 }
 
+// snapshot returns an iterator over the elements returned by read,
+// which is called on the first call to Next.
+func snapshot[E any](read func() []E) Iter[E] {
+	var s []E
+	var started bool
+	var i int
+	return IterFunc[E](func() (E, bool) {
+		if !started {
+			started = true
+			s = read()
+		}
+		if i < len(s) {
+			e := s[i]
+			// clear the slot to not retain the element
+			s[i] = zero[E]()
+			i++
+			return e, true
+		}
+		return zero[E](), false
+	})
+}
+
+func entries[K comparable, V any](m map[K]V) []MapEntry[K, V] {
+	s := make([]MapEntry[K, V], 0, len(m))
+	for k, v := range m {
+		s = append(s, MapEntry[K, V]{Key: k, Value: v})
+	}
+	return s
+}
+
 // FromMap creates an iterator from a map.
+// The entries are read from the map on the first call to Next,
+// so later changes to the map are not reflected by the iterator.
 func FromMap[K comparable, V any](m map[K]V) Iter[MapEntry[K, V]] {
-	r := reflect.ValueOf(m).MapRange()
-	return IterFunc[MapEntry[K, V]](func() (MapEntry[K, V], bool) {
-		if !r.Next() {
-			return zero[MapEntry[K, V]](), false
-		}
-		return MapEntry[K, V]{
-			Key:   r.Key().Interface().(K),
-			Value: r.Value().Interface().(V),
-		}, true
+	return snapshot(func() []MapEntry[K, V] { return entries(m) })
+}
+
+// FromMapSorted creates an iterator from a map, yielding
+// the entries in ascending order of their keys.
+// As for FromMap, the entries are read on the first call to Next.
+func FromMapSorted[K cmp.Ordered, V any](m map[K]V) Iter[MapEntry[K, V]] {
+	return snapshot(func() []MapEntry[K, V] {
+		s := entries(m)
+		slices.SortFunc(s, func(a, b MapEntry[K, V]) int { return cmp.Compare(a.Key, b.Key) })
+		return s
+	})
+}
+
+// FromMapSortedFunc creates an iterator from a map, yielding
+// the entries in the order of their keys defined by less.
+// As for FromMap, the entries are read on the first call to Next.
+func FromMapSortedFunc[K comparable, V any](m map[K]V, less func(a, b K) bool) Iter[MapEntry[K, V]] {
+	return snapshot(func() []MapEntry[K, V] {
+		s := entries(m)
+		slices.SortFunc(s, func(a, b MapEntry[K, V]) int {
+			switch {
+			case less(a.Key, b.Key):
+				return -1
+			case less(b.Key, a.Key):
+				return 1
+			}
+			return 0
+		})
+		return s
 	})
 }
 
 // Keys returns an iterator for the keys of the map.
+// As for FromMap, the keys are read on the first call to Next.
 func Keys[K comparable, V any](m map[K]V) Iter[K] {
-	return Map(FromMap(m), func(e MapEntry[K, V]) K { return e.Key })
+	return snapshot(func() []K {
+		s := make([]K, 0, len(m))
+		for k := range m {
+			s = append(s, k)
+		}
+		return s
+	})
 }
 
 // Values returns an iterator for the values of the map.
+// As for FromMap, the values are read on the first call to Next.
 func Values[K comparable, V any](m map[K]V) Iter[V] {
-	return Map(FromMap(m), func(e MapEntry[K, V]) V { return e.Value })
+	return snapshot(func() []V {
+		s := make([]V, 0, len(m))
+		for _, v := range m {
+			s = append(s, v)
+		}
+		return s
+	})
 }
@@ -1,6 +1,7 @@
 package iter_test
 
 import (
+	"fmt"
 	"reflect"
 	"sort"
 	"testing"
@@ -72,3 +73,96 @@ func TestValues(t *testing.T) {
 		})
 	}
 }
+
+func TestFromMapSorted(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    map[string]int
+		expected []iter.MapEntry[string, int]
+	}{
+		{"FromMapSorted with multiple entries", map[string]int{"c": 3, "a": 1, "b": 2}, []iter.MapEntry[string, int]{{"a", 1}, {"b", 2}, {"c", 3}}},
+		{"FromMapSorted with empty map", map[string]int{}, []iter.MapEntry[string, int]{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := iter.Slice(iter.FromMapSorted(tt.input))
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestFromMapSortedFunc(t *testing.T) {
+	m := map[int]string{1: "a", 3: "c", 2: "b"}
+	desc := func(a, b int) bool { return a > b }
+	result := iter.Slice(iter.FromMapSortedFunc(m, desc))
+	expected := []iter.MapEntry[int, string]{{3, "c"}, {2, "b"}, {1, "a"}}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
+
+// fromMapReflect is the reflection-based implementation
+// of FromMap, kept to compare the performance against it.
+func fromMapReflect[K comparable, V any](m map[K]V) iter.Iter[iter.MapEntry[K, V]] {
+	r := reflect.ValueOf(m).MapRange()
+	return iter.IterFunc[iter.MapEntry[K, V]](func() (iter.MapEntry[K, V], bool) {
+		if !r.Next() {
+			return iter.MapEntry[K, V]{}, false
+		}
+		return iter.MapEntry[K, V]{
+			Key:   r.Key().Interface().(K),
+			Value: r.Value().Interface().(V),
+		}, true
+	})
+}
+
+func benchmarkMap() map[int]string {
+	m := make(map[int]string, 1000)
+	for i := 0; i < 1000; i++ {
+		m[i] = fmt.Sprint(i)
+	}
+	return m
+}
+
+func BenchmarkFromMap(b *testing.B) {
+	m := benchmarkMap()
+
+	b.Run("reflect", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			iter.ForEach(fromMapReflect(m), func(iter.MapEntry[int, string]) {})
+		}
+	})
+	b.Run("FromMap", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			iter.ForEach(iter.FromMap(m), func(iter.MapEntry[int, string]) {})
+		}
+	})
+	b.Run("FromMapSorted", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			iter.ForEach(iter.FromMapSorted(m), func(iter.MapEntry[int, string]) {})
+		}
+	})
+}
+
+func BenchmarkKeys(b *testing.B) {
+	m := benchmarkMap()
+
+	b.Run("reflect", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			iter.ForEach(iter.Map(fromMapReflect(m), func(e iter.MapEntry[int, string]) int { return e.Key }), func(int) {})
+		}
+	})
+	b.Run("Keys", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			iter.ForEach(iter.Keys(m), func(int) {})
+		}
+	})
+}
@@ -0,0 +1,75 @@
+package iter
+
+import (
+	"cmp"
+	"container/heap"
+)
+
+// mergeHeap is a min-heap of the head elements of the iterators
+// merged by MergeSorted, ordered by value and then by iterator
+// position to keep the merge stable.
+type mergeHeap[E any] struct {
+	less  func(a, b E) bool
+	items []indexed[E]
+}
+
+func (h *mergeHeap[E]) Len() int { return len(h.items) }
+
+func (h *mergeHeap[E]) Less(i, j int) bool {
+	a, b := h.items[i], h.items[j]
+	if h.less(a.val, b.val) {
+		return true
+	}
+	if h.less(b.val, a.val) {
+		return false
+	}
+	return a.idx < b.idx
+}
+
+func (h *mergeHeap[E]) Swap(i, j int) { h.items[i], h.items[j] = h.items[j], h.items[i] }
+
+func (h *mergeHeap[E]) Push(x any) { h.items = append(h.items, x.(indexed[E])) }
+
+func (h *mergeHeap[E]) Pop() any {
+	n := len(h.items) - 1
+	x := h.items[n]
+	h.items = h.items[:n]
+	return x
+}
+
+// MergeSorted merges the iterators, each one sorted according to less,
+// into a single sorted iterator. Equal elements are yielded in the order
+// of the iterators they come from. Only the next element of each
+// iterator is kept in memory, and each step takes O(log k) for k iterators.
+func MergeSorted[E any](less func(a, b E) bool, its ...Iter[E]) Iter[E] {
+	h := &mergeHeap[E]{less: less}
+	var started bool
+	return withClose(func() (E, bool) {
+		if !started {
+			started = true
+			for i, it := range its {
+				if e, ok := it.Next(); ok {
+					h.items = append(h.items, indexed[E]{idx: i, val: e})
+				}
+			}
+			heap.Init(h)
+		}
+		if h.Len() == 0 {
+			return zero[E](), false
+		}
+		top := h.items[0]
+		if e, ok := its[top.idx].Next(); ok {
+			h.items[0].val = e
+			heap.Fix(h, 0)
+		} else {
+			heap.Pop(h)
+		}
+		return top.val, true
+	}, anys(its)...)
+}
+
+// MergeSortedOrdered is like MergeSorted, for iterators
+// sorted in ascending order of an ordered type.
+func MergeSortedOrdered[E cmp.Ordered](its ...Iter[E]) Iter[E] {
+	return MergeSorted(cmp.Less[E], its...)
+}
@@ -0,0 +1,62 @@
+package iter_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gmgigi96/iter"
+)
+
+func TestMergeSortedOrdered(t *testing.T) {
+	tests := []struct {
+		name     string
+		inputs   [][]int
+		expected []int
+	}{
+		{"MergeSortedOrdered three inputs", [][]int{{1, 4, 7}, {2, 5, 8}, {3, 6, 9}}, []int{1, 2, 3, 4, 5, 6, 7, 8, 9}},
+		{"MergeSortedOrdered with duplicates", [][]int{{1, 1, 3}, {1, 2}}, []int{1, 1, 1, 2, 3}},
+		{"MergeSortedOrdered with empty inputs", [][]int{{}, {1, 2}, {}}, []int{1, 2}},
+		{"MergeSortedOrdered without inputs", [][]int{}, []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			its := make([]iter.Iter[int], len(tt.inputs))
+			for i, in := range tt.inputs {
+				its[i] = iter.FromSlice(in)
+			}
+			result := iter.Slice(iter.MergeSortedOrdered(its...))
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestMergeSortedStable(t *testing.T) {
+	type item struct {
+		key int
+		src string
+	}
+	less := func(a, b item) bool { return a.key < b.key }
+	it := iter.MergeSorted(less,
+		iter.FromSlice([]item{{1, "a"}, {2, "a"}}),
+		iter.FromSlice([]item{{1, "b"}, {2, "b"}}),
+		iter.FromSlice([]item{{1, "c"}}),
+	)
+	result := iter.Slice(it)
+	expected := []item{{1, "a"}, {1, "b"}, {1, "c"}, {2, "a"}, {2, "b"}}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
+
+func TestMergeSortedStreaming(t *testing.T) {
+	evens := iter.Count(0, 2)
+	odds := iter.Count(1, 2)
+	result := iter.Slice(iter.TakeWhile(iter.MergeSortedOrdered(evens, odds), func(e int) bool { return e < 6 }))
+	expected := []int{0, 1, 2, 3, 4, 5}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
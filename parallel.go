@@ -0,0 +1,192 @@
+package iter
+
+import (
+	"runtime/debug"
+	"sync"
+)
+
+// indexed is an element tagged with its position in the upstream iterator.
+type indexed[E any] struct {
+	idx int
+	val E
+}
+
+// parallelIter is an iterator mapping the elements
+// of the upstream iterator using a pool of goroutines.
+type parallelIter[E, T any] struct {
+	it      Iter[E]
+	f       func(E) T
+	workers int
+	ordered bool
+
+	started bool
+	closed  bool
+	done    chan struct{}
+	window  chan struct{}
+	results chan indexed[T]
+	panics  chan *PanicError
+	pending map[int]T
+	nextIdx int
+}
+
+// ParallelMap maps the elements of the iterator to another type
+// based on the provided function, running it concurrently on the
+// given number of goroutines. The elements are yielded in the same
+// order as in the upstream iterator, and at most 2*workers elements
+// are in flight at any time.
+// The goroutines are started on the first call to Next. Close
+// stops them, and then closes the upstream iterator: it must be
+// called when the iterator is abandoned before being exhausted.
+// If f or the upstream iterator panics, the goroutines are stopped,
+// the upstream iterator is closed, and Next panics with a *PanicError.
+func ParallelMap[E, T any](it Iter[E], workers int, f func(E) T) Iter[T] {
+	return newParallelIter(it, workers, f, true)
+}
+
+// ParallelMapUnordered is like ParallelMap, but yields
+// the elements as soon as they are mapped, regardless
+// of their order in the upstream iterator.
+func ParallelMapUnordered[E, T any](it Iter[E], workers int, f func(E) T) Iter[T] {
+	return newParallelIter(it, workers, f, false)
+}
+
+func newParallelIter[E, T any](it Iter[E], workers int, f func(E) T, ordered bool) *parallelIter[E, T] {
+	if workers <= 0 {
+		panic("workers must be positive")
+	}
+	return &parallelIter[E, T]{
+		it:      it,
+		f:       f,
+		workers: workers,
+		ordered: ordered,
+		pending: make(map[int]T),
+	}
+}
+
+func (p *parallelIter[E, T]) start() {
+	p.started = true
+	p.done = make(chan struct{})
+	p.window = make(chan struct{}, 2*p.workers)
+	p.results = make(chan indexed[T], 2*p.workers)
+	p.panics = make(chan *PanicError, 1)
+	jobs := make(chan indexed[E])
+
+	var wg sync.WaitGroup
+	wg.Add(p.workers + 1)
+	go func() {
+		defer wg.Done()
+		defer close(jobs)
+		defer p.catch()
+		for i := 0; ; i++ {
+			select {
+			case p.window <- struct{}{}:
+			case <-p.done:
+				return
+			}
+			e, ok := p.it.Next()
+			if !ok {
+				return
+			}
+			select {
+			case jobs <- indexed[E]{idx: i, val: e}:
+			case <-p.done:
+				return
+			}
+		}
+	}()
+	for i := 0; i < p.workers; i++ {
+		go func() {
+			defer wg.Done()
+			defer p.catch()
+			for j := range jobs {
+				select {
+				case p.results <- indexed[T]{idx: j.idx, val: p.f(j.val)}:
+				case <-p.done:
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(p.results)
+	}()
+}
+
+// catch recovers a panic of the calling goroutine, reporting
+// it to the consumer. Only the first panic is kept.
+func (p *parallelIter[E, T]) catch() {
+	if r := recover(); r != nil {
+		select {
+		case p.panics <- &PanicError{Value: r, Stack: debug.Stack()}:
+		default:
+		}
+	}
+}
+
+// repanic stops the goroutines and panics
+// with the *PanicError of a goroutine.
+func (p *parallelIter[E, T]) repanic(pe *PanicError) {
+	p.Close()
+	panic(pe)
+}
+
+func (p *parallelIter[E, T]) Next() (T, bool) {
+	if p.closed {
+		return zero[T](), false
+	}
+	if !p.started {
+		p.start()
+	}
+	for {
+		if p.ordered {
+			if v, ok := p.pending[p.nextIdx]; ok {
+				delete(p.pending, p.nextIdx)
+				p.nextIdx++
+				<-p.window
+				return v, true
+			}
+		}
+		var r indexed[T]
+		var ok bool
+		select {
+		case pe := <-p.panics:
+			p.repanic(pe)
+		default:
+			select {
+			case pe := <-p.panics:
+				p.repanic(pe)
+			case r, ok = <-p.results:
+			}
+		}
+		if !ok {
+			// the goroutines may have exited because of a panic
+			select {
+			case pe := <-p.panics:
+				p.repanic(pe)
+			default:
+			}
+			return zero[T](), false
+		}
+		if !p.ordered {
+			<-p.window
+			return r.val, true
+		}
+		p.pending[r.idx] = r.val
+	}
+}
+
+// Close stops the goroutines and closes the upstream iterator.
+func (p *parallelIter[E, T]) Close() error {
+	if p.closed {
+		return nil
+	}
+	p.closed = true
+	if p.started {
+		close(p.done)
+		for range p.results {
+		}
+	}
+	p.pending = nil
+	return Close(p.it)
+}
@@ -0,0 +1,125 @@
+package iter_test
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+	"sync/atomic"
+	"testing"
+
+	"github.com/gmgigi96/iter"
+)
+
+func TestParallelMap(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []int
+		workers  int
+		expected []int
+	}{
+		{"ParallelMap with 4 workers", []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, 4, []int{1, 4, 9, 16, 25, 36, 49, 64, 81, 100}},
+		{"ParallelMap with 1 worker", []int{1, 2, 3}, 1, []int{1, 4, 9}},
+		{"ParallelMap from empty slice", []int{}, 4, []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			square := func(e int) int { return e * e }
+
+			result := iter.Slice(iter.ParallelMap(iter.FromSlice(tt.input), tt.workers, square))
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+
+			result = iter.Slice(iter.ParallelMapUnordered(iter.FromSlice(tt.input), tt.workers, square))
+			sort.Ints(result)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestParallelMapBoundedWindow(t *testing.T) {
+	var pulled atomic.Int64
+	src := iter.Map(iter.Count(0, 1), func(e int) int {
+		pulled.Add(1)
+		return e
+	})
+	it := iter.ParallelMap(src, 2, func(e int) int { return e })
+	for i := 0; i < 3; i++ {
+		if e, _ := it.Next(); e != i {
+			t.Fatalf("Expected %d, got %d", i, e)
+		}
+	}
+	if err := iter.Close(it); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if n := pulled.Load(); n > 3+4 {
+		t.Errorf("Expected at most 7 elements pulled from upstream, got %d", n)
+	}
+}
+
+func TestParallelMapClose(t *testing.T) {
+	src := &tracker{Iter: iter.Count(0, 1)}
+	it := iter.ParallelMapUnordered[int](src, 4, func(e int) int { return e })
+	it.Next()
+	if err := iter.Close(it); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if src.closed != 1 {
+		t.Errorf("Expected upstream to be closed once, got %d", src.closed)
+	}
+	if _, ok := it.Next(); ok {
+		t.Errorf("Expected closed iterator to be exhausted")
+	}
+}
+
+func TestParallelMapPanic(t *testing.T) {
+	tests := []struct {
+		name string
+		src  func() iter.Iter[int]
+		f    func(int) int
+	}{
+		{"ParallelMap with panicking function", func() iter.Iter[int] { return iter.Count(0, 1) }, func(e int) int {
+			if e == 5 {
+				panic("boom")
+			}
+			return e
+		}},
+		{"ParallelMap with panicking upstream", func() iter.Iter[int] {
+			return iter.Map(iter.Count(0, 1), func(e int) int {
+				if e == 5 {
+					panic("boom")
+				}
+				return e
+			})
+		}, func(e int) int { return e }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, ordered := range []bool{true, false} {
+				src := &tracker{Iter: tt.src()}
+				var it iter.Iter[int]
+				if ordered {
+					it = iter.ParallelMap[int](src, 4, tt.f)
+				} else {
+					it = iter.ParallelMapUnordered[int](src, 4, tt.f)
+				}
+				func() {
+					defer func() {
+						var pe *iter.PanicError
+						if err, _ := recover().(error); !errors.As(err, &pe) || pe.Value != "boom" {
+							t.Errorf("Expected PanicError with value boom, got %v", err)
+						}
+					}()
+					iter.Slice(it)
+				}()
+				if src.closed != 1 {
+					t.Errorf("Expected upstream to be closed once, got %d", src.closed)
+				}
+			}
+		})
+	}
+}
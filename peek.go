@@ -0,0 +1,82 @@
+package iter
+
+// PeekIter is an iterator allowing to look at the
+// upcoming elements without consuming them.
+type PeekIter[E any] struct {
+	it  Iter[E]
+	buf ring[E]
+}
+
+// Peekable returns a PeekIter reading the elements from the iterator.
+// If the iterator is already a PeekIter, it is returned as is.
+func Peekable[E any](it Iter[E]) *PeekIter[E] {
+	if p, ok := it.(*PeekIter[E]); ok {
+		return p
+	}
+	return &PeekIter[E]{it: it}
+}
+
+// fill reads from the upstream iterator until at least n
+// elements are buffered, reporting whether it succeeded.
+func (p *PeekIter[E]) fill(n int) bool {
+	for p.buf.len() < n {
+		e, ok := p.it.Next()
+		if !ok {
+			return false
+		}
+		p.buf.pushBack(e)
+	}
+	return true
+}
+
+// Next returns the next element in the iterator.
+func (p *PeekIter[E]) Next() (E, bool) {
+	if !p.fill(1) {
+		return zero[E](), false
+	}
+	return p.buf.popFront(), true
+}
+
+// Peek returns the next element in the iterator without consuming it.
+func (p *PeekIter[E]) Peek() (E, bool) {
+	if !p.fill(1) {
+		return zero[E](), false
+	}
+	return p.buf.at(0), true
+}
+
+// PeekN returns up to n of the next elements in the iterator
+// without consuming them. Less than n elements are returned
+// only if the iterator is exhausted. It panics if n is negative.
+func (p *PeekIter[E]) PeekN(n int) []E {
+	if n < 0 {
+		panic("n cannot be negative")
+	}
+	p.fill(n)
+	s := make([]E, min(n, p.buf.len()))
+	for i := range s {
+		s[i] = p.buf.at(i)
+	}
+	return s
+}
+
+// Unread pushes back the element, so that
+// it is returned by the next call to Next.
+func (p *PeekIter[E]) Unread(e E) {
+	p.buf.pushFront(e)
+}
+
+// NextIf consumes and returns the next element
+// only if the provided function returns true for it.
+func (p *PeekIter[E]) NextIf(pred func(E) bool) (E, bool) {
+	e, ok := p.Peek()
+	if !ok || !pred(e) {
+		return zero[E](), false
+	}
+	return p.buf.popFront(), true
+}
+
+// Close closes the upstream iterator.
+func (p *PeekIter[E]) Close() error {
+	return Close(p.it)
+}
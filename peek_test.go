@@ -0,0 +1,107 @@
+package iter_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gmgigi96/iter"
+)
+
+func TestPeek(t *testing.T) {
+	p := iter.Peekable(iter.FromSlice([]int{1, 2}))
+	for _, expected := range []int{1, 1} {
+		if e, ok := p.Peek(); !ok || e != expected {
+			t.Errorf("Expected %v, got %v", expected, e)
+		}
+	}
+	if e, ok := p.Next(); !ok || e != 1 {
+		t.Errorf("Expected 1, got %v", e)
+	}
+	if e, ok := p.Peek(); !ok || e != 2 {
+		t.Errorf("Expected 2, got %v", e)
+	}
+	p.Next()
+	if _, ok := p.Peek(); ok {
+		t.Errorf("Expected exhausted iterator")
+	}
+}
+
+func TestPeekN(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []int
+		n        int
+		expected []int
+	}{
+		{"PeekN less than available", []int{1, 2, 3, 4}, 2, []int{1, 2}},
+		{"PeekN more than available", []int{1, 2}, 5, []int{1, 2}},
+		{"PeekN from empty slice", []int{}, 3, []int{}},
+		{"PeekN zero elements", []int{1, 2}, 0, []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := iter.Peekable(iter.FromSlice(tt.input))
+			result := p.PeekN(tt.n)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+			rest := iter.Slice[int](p)
+			if !reflect.DeepEqual(rest, tt.input) {
+				t.Errorf("Expected %v, got %v", tt.input, rest)
+			}
+		})
+	}
+}
+
+func TestPeekNNegative(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Expected panic for negative n")
+		}
+	}()
+	iter.Peekable(iter.Range(3)).PeekN(-1)
+}
+
+func TestUnread(t *testing.T) {
+	p := iter.Peekable(iter.Range2(3, 10))
+	p.PeekN(5)
+	for i := 2; i >= 0; i-- {
+		p.Unread(i)
+	}
+	result := iter.Slice[int](p)
+	expected := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
+
+func TestNextIf(t *testing.T) {
+	p := iter.Peekable(iter.FromSlice([]int{1, 2, 3}))
+	odd := func(e int) bool { return e%2 == 1 }
+	if e, ok := p.NextIf(odd); !ok || e != 1 {
+		t.Errorf("Expected 1, got %v", e)
+	}
+	if _, ok := p.NextIf(odd); ok {
+		t.Errorf("Expected 2 not to be consumed")
+	}
+	if e, _ := p.Next(); e != 2 {
+		t.Errorf("Expected 2, got %v", e)
+	}
+}
+
+func TestPeekableTakeWhile(t *testing.T) {
+	src := &tracker{Iter: iter.Range(6)}
+	p := iter.Peekable[int](src)
+	head := iter.Slice(iter.TakeWhile[int](p, func(e int) bool { return e < 3 }))
+	rest := iter.Slice[int](p)
+	if !reflect.DeepEqual(head, []int{0, 1, 2}) {
+		t.Errorf("Expected %v, got %v", []int{0, 1, 2}, head)
+	}
+	if !reflect.DeepEqual(rest, []int{3, 4, 5}) {
+		t.Errorf("Expected %v, got %v", []int{3, 4, 5}, rest)
+	}
+	if src.closed != 0 {
+		t.Errorf("Expected upstream not to be closed, got %d", src.closed)
+	}
+}
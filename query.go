@@ -0,0 +1,65 @@
+package iter
+
+// Any reports whether the provided function returns true for any
+// element of the iterator, stopping at the first one that matches.
+func Any[E any](it Iter[E], pred func(E) bool) bool {
+	_, ok := Find(it, pred)
+	return ok
+}
+
+// All reports whether the provided function returns true for all
+// the elements of the iterator, stopping at the first one that does not match.
+func All[E any](it Iter[E], pred func(E) bool) bool {
+	return !Any(it, func(e E) bool { return !pred(e) })
+}
+
+// None reports whether the provided function returns false for all
+// the elements of the iterator, stopping at the first one that matches.
+func None[E any](it Iter[E], pred func(E) bool) bool {
+	return !Any(it, pred)
+}
+
+// Find returns the first element of the iterator
+// for which the provided function returns true.
+func Find[E any](it Iter[E], pred func(E) bool) (E, bool) {
+	for e, ok := it.Next(); ok; e, ok = it.Next() {
+		if pred(e) {
+			return e, true
+		}
+	}
+	return zero[E](), false
+}
+
+// Position returns the index, as Enumerate would return it, of the first
+// element of the iterator for which the provided function returns true.
+func Position[E any](it Iter[E], pred func(E) bool) (int, bool) {
+	e, ok := Find(Enumerate(it), func(e Enum[E]) bool { return pred(e.Value) })
+	if !ok {
+		return -1, false
+	}
+	return e.Index, true
+}
+
+// Contains reports whether the iterator contains the element,
+// stopping at the first occurrence.
+func Contains[E comparable](it Iter[E], e E) bool {
+	return Any(it, func(x E) bool { return x == e })
+}
+
+// Len consumes the iterator and returns the number of its elements.
+// It is not named Count, which is the infinite counter.
+func Len[E any](it Iter[E]) int {
+	return CountWhere(it, func(E) bool { return true })
+}
+
+// CountWhere consumes the iterator and returns the number
+// of elements for which the provided function returns true.
+func CountWhere[E any](it Iter[E], pred func(E) bool) int {
+	var n int
+	for e, ok := it.Next(); ok; e, ok = it.Next() {
+		if pred(e) {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,99 @@
+package iter_test
+
+import (
+	"testing"
+
+	"github.com/gmgigi96/iter"
+)
+
+func TestAnyAllNone(t *testing.T) {
+	even := func(e int) bool { return e%2 == 0 }
+
+	tests := []struct {
+		name           string
+		input          []int
+		any, all, none bool
+	}{
+		{"Some even numbers", []int{1, 2, 3}, true, false, false},
+		{"All even numbers", []int{2, 4}, true, true, false},
+		{"No even numbers", []int{1, 3}, false, false, true},
+		{"Empty slice", []int{}, false, true, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := iter.Any(iter.FromSlice(tt.input), even); result != tt.any {
+				t.Errorf("Expected Any %v, got %v", tt.any, result)
+			}
+			if result := iter.All(iter.FromSlice(tt.input), even); result != tt.all {
+				t.Errorf("Expected All %v, got %v", tt.all, result)
+			}
+			if result := iter.None(iter.FromSlice(tt.input), even); result != tt.none {
+				t.Errorf("Expected None %v, got %v", tt.none, result)
+			}
+		})
+	}
+}
+
+func TestShortCircuitOnInfinite(t *testing.T) {
+	if !iter.Any(iter.Count(0, 1), func(e int) bool { return e > 100 }) {
+		t.Errorf("Expected Any to find an element")
+	}
+	if iter.All(iter.Cycle(iter.Range(3)), func(e int) bool { return e < 2 }) {
+		t.Errorf("Expected All to be false")
+	}
+	if iter.None(iter.Repeat(1, -1), func(e int) bool { return e == 1 }) {
+		t.Errorf("Expected None to be false")
+	}
+	if !iter.Contains(iter.Count(0, 3), 99) {
+		t.Errorf("Expected Contains to find 99")
+	}
+}
+
+func TestFind(t *testing.T) {
+	it := iter.Count(1, 1)
+	if e, ok := iter.Find(it, func(e int) bool { return e%7 == 0 }); !ok || e != 7 {
+		t.Errorf("Expected 7, got %v", e)
+	}
+	if e, _ := it.Next(); e != 8 {
+		t.Errorf("Expected Find to stop at 7, got next %v", e)
+	}
+	if _, ok := iter.Find(iter.Range(3), func(e int) bool { return e > 5 }); ok {
+		t.Errorf("Expected no element found")
+	}
+}
+
+func TestPosition(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []string
+		target   string
+		expected int
+		ok       bool
+	}{
+		{"Position of existing element", []string{"a", "b", "c"}, "b", 1, true},
+		{"Position of first occurrence", []string{"a", "b", "b"}, "b", 1, true},
+		{"Position of missing element", []string{"a"}, "z", -1, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, ok := iter.Position(iter.FromSlice(tt.input), func(s string) bool { return s == tt.target })
+			if result != tt.expected || ok != tt.ok {
+				t.Errorf("Expected %v, %v, got %v, %v", tt.expected, tt.ok, result, ok)
+			}
+		})
+	}
+}
+
+func TestLenAndCountWhere(t *testing.T) {
+	if n := iter.Len(iter.Range(5)); n != 5 {
+		t.Errorf("Expected 5, got %v", n)
+	}
+	if n := iter.Len(iter.Range(0)); n != 0 {
+		t.Errorf("Expected 0, got %v", n)
+	}
+	if n := iter.CountWhere(iter.Range(10), func(e int) bool { return e%3 == 0 }); n != 4 {
+		t.Errorf("Expected 4, got %v", n)
+	}
+}
@@ -0,0 +1,125 @@
+package iter
+
+import "math"
+
+// RangeOf returns an iterator for a range of integers of any type
+// between start and stop with a specified step. Differently from
+// a plain loop, the iteration stops instead of wrapping around
+// when the next value would overflow the type.
+func RangeOf[T Integer](start, stop, step T) Iter[T] {
+	if step == 0 {
+		panic("step cannot be zero")
+	}
+	var overflow bool
+	return IterFunc[T](func() (T, bool) {
+		if overflow || step > 0 && start >= stop || step < 0 && start <= stop {
+			return 0, false
+		}
+		curr := start
+		start += step
+		overflow = (step > 0) != (start > curr)
+		return curr, true
+	})
+}
+
+// isFloat reports whether T is a floating-point type.
+func isFloat[T Number]() bool {
+	return T(1)/T(2) != 0
+}
+
+// CountOf returns an iterator starting from the given value and
+// incrementing by the specified step, for any integer or floating-point
+// type. For integers, the iteration stops when the next value would
+// overflow the type. For floating-point numbers, each value is computed
+// as start + i*step to avoid accumulating rounding errors.
+func CountOf[T Number](start, step T) Iter[T] {
+	if isFloat[T]() {
+		var i int
+		return IterFunc[T](func() (T, bool) {
+			res := start + T(i)*step
+			i++
+			return res, true
+		})
+	}
+	var overflow bool
+	return IterFunc[T](func() (T, bool) {
+		if overflow {
+			return 0, false
+		}
+		curr := start
+		start += step
+		overflow = step != 0 && (step > 0) != (start > curr)
+		return curr, true
+	})
+}
+
+// floats returns an iterator of the n values returned by f for
+// the indexes from 0 to n-1.
+func floats(n int, f func(i int) float64) Iter[float64] {
+	return Map(Range(n), f)
+}
+
+// Linspace returns an iterator of n evenly spaced numbers
+// between start and stop, both included.
+func Linspace(start, stop float64, n int) Iter[float64] {
+	if n < 0 {
+		panic("n cannot be negative")
+	}
+	step := (stop - start) / float64(n-1)
+	return floats(n, func(i int) float64 {
+		switch i {
+		case 0:
+			return start
+		case n - 1:
+			return stop
+		}
+		return start + float64(i)*step
+	})
+}
+
+// Arange returns an iterator of evenly spaced numbers between
+// start, included, and stop, excluded, with a specified step.
+// Each value is computed as start + i*step to avoid
+// accumulating rounding errors. It panics if step is zero,
+// if any of the arguments is infinite or NaN, or if the
+// number of values does not fit in an int.
+func Arange(start, stop, step float64) Iter[float64] {
+	if step == 0 {
+		panic("step cannot be zero")
+	}
+	for _, x := range []float64{start, stop, step} {
+		if math.IsInf(x, 0) || math.IsNaN(x) {
+			panic("start, stop and step must be finite")
+		}
+	}
+	q := math.Ceil((stop - start) / step)
+	if q >= math.MaxInt {
+		panic("too many values")
+	}
+	return floats(int(max(q, 0)), func(i int) float64 {
+		return start + float64(i)*step
+	})
+}
+
+// Geomspace returns an iterator of n numbers between start and stop,
+// both included, evenly spaced on a logarithmic scale, so that each
+// one is a constant multiple of the previous one.
+// Start and stop must be non-zero and have the same sign.
+func Geomspace(start, stop float64, n int) Iter[float64] {
+	if n < 0 {
+		panic("n cannot be negative")
+	}
+	if start == 0 || stop == 0 || (start < 0) != (stop < 0) {
+		panic("start and stop must be non-zero and have the same sign")
+	}
+	ratio := stop / start
+	return floats(n, func(i int) float64 {
+		switch i {
+		case 0:
+			return start
+		case n - 1:
+			return stop
+		}
+		return start * math.Pow(ratio, float64(i)/float64(n-1))
+	})
+}
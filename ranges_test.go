@@ -0,0 +1,158 @@
+package iter_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/gmgigi96/iter"
+)
+
+func TestRangeOf(t *testing.T) {
+	tests := []struct {
+		name              string
+		start, stop, step int8
+		expected          []int8
+	}{
+		{"RangeOf from 1 to 10 with step 3", 1, 10, 3, []int8{1, 4, 7}},
+		{"RangeOf with negative step", 3, -3, -2, []int8{3, 1, -1}},
+		{"RangeOf stopping at overflow", 120, math.MaxInt8, 5, []int8{120, 125}},
+		{"RangeOf stopping at negative overflow", -120, math.MinInt8, -5, []int8{-120, -125}},
+		{"RangeOf empty", 5, 5, 1, []int8{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := iter.Slice(iter.RangeOf(tt.start, tt.stop, tt.step))
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestRangeOfUnsigned(t *testing.T) {
+	result := iter.Slice(iter.RangeOf[uint64](math.MaxUint64-5, math.MaxUint64, 4))
+	expected := []uint64{math.MaxUint64 - 5, math.MaxUint64 - 1}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
+
+func TestRange3Overflow(t *testing.T) {
+	result := iter.Slice(iter.Range3(math.MaxInt-1, math.MaxInt, 5))
+	if !reflect.DeepEqual(result, []int{math.MaxInt - 1}) {
+		t.Errorf("Expected %v, got %v", []int{math.MaxInt - 1}, result)
+	}
+}
+
+func TestCountOf(t *testing.T) {
+	ints := iter.Slice(iter.CountOf[uint8](250, 2))
+	if !reflect.DeepEqual(ints, []uint8{250, 252, 254}) {
+		t.Errorf("Expected %v, got %v", []uint8{250, 252, 254}, ints)
+	}
+
+	floats := iter.Slice(iter.Take(iter.CountOf(0.0, 0.1), 11))
+	if floats[10] != 1.0 {
+		t.Errorf("Expected 1 without drift, got %v", floats[10])
+	}
+}
+
+func TestLinspace(t *testing.T) {
+	tests := []struct {
+		name        string
+		start, stop float64
+		n           int
+		expected    []float64
+	}{
+		{"Linspace from 0 to 1", 0, 1, 5, []float64{0, 0.25, 0.5, 0.75, 1}},
+		{"Linspace decreasing", 2, 0, 3, []float64{2, 1, 0}},
+		{"Linspace single value", 3, 7, 1, []float64{3}},
+		{"Linspace no values", 0, 1, 0, []float64{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := iter.Slice(iter.Linspace(tt.start, tt.stop, tt.n))
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestArange(t *testing.T) {
+	tests := []struct {
+		name              string
+		start, stop, step float64
+		expected          []float64
+	}{
+		{"Arange from 0 to 1 with step 0.25", 0, 1, 0.25, []float64{0, 0.25, 0.5, 0.75}},
+		{"Arange with negative step", 1, 0, -0.5, []float64{1, 0.5}},
+		{"Arange empty", 1, 0, 0.5, []float64{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := iter.Slice(iter.Arange(tt.start, tt.stop, tt.step))
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestArangeNoDrift(t *testing.T) {
+	result := iter.Slice(iter.Arange(0, 1, 0.1))
+	if len(result) != 10 {
+		t.Fatalf("Expected 10 values, got %v", result)
+	}
+	if result[9] != 0.9 {
+		t.Errorf("Expected 0.9, got %v", result[9])
+	}
+}
+
+func TestArangeInvalid(t *testing.T) {
+	tests := []struct {
+		name              string
+		start, stop, step float64
+	}{
+		{"Arange to infinity", 0, math.Inf(1), 1},
+		{"Arange from negative infinity", math.Inf(-1), 0, 1},
+		{"Arange with infinite step", 0, 1, math.Inf(1)},
+		{"Arange with NaN", 0, math.NaN(), 1},
+		{"Arange with too many values", 0, 1e19, 1},
+		{"Arange with overflowing difference", -1e308, 1e308, 1e300},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("Expected panic for invalid arguments")
+				}
+			}()
+			iter.Arange(tt.start, tt.stop, tt.step)
+		})
+	}
+}
+
+func TestGeomspace(t *testing.T) {
+	result := iter.Slice(iter.Geomspace(1, 1000, 4))
+	expected := []float64{1, 10, 100, 1000}
+	for i := range expected {
+		if math.Abs(result[i]-expected[i]) > 1e-9 {
+			t.Errorf("Expected %v, got %v", expected, result)
+			break
+		}
+	}
+
+	result = iter.Slice(iter.Geomspace(-1, -16, 5))
+	expected = []float64{-1, -2, -4, -8, -16}
+	for i := range expected {
+		if math.Abs(result[i]-expected[i]) > 1e-9 {
+			t.Errorf("Expected %v, got %v", expected, result)
+			break
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package iter
+
+// ring is a double-ended queue backed by a circular buffer,
+// growing as needed.
+type ring[E any] struct {
+	buf  []E
+	head int
+	size int
+}
+
+func (r *ring[E]) len() int {
+	return r.size
+}
+
+// at returns the i-th element from the front of the queue.
+func (r *ring[E]) at(i int) E {
+	return r.buf[(r.head+i)%len(r.buf)]
+}
+
+func (r *ring[E]) grow() {
+	if r.size < len(r.buf) {
+		return
+	}
+	buf := make([]E, max(2*len(r.buf), 4))
+	for i := 0; i < r.size; i++ {
+		buf[i] = r.at(i)
+	}
+	r.buf = buf
+	r.head = 0
+}
+
+func (r *ring[E]) pushBack(e E) {
+	r.grow()
+	r.buf[(r.head+r.size)%len(r.buf)] = e
+	r.size++
+}
+
+func (r *ring[E]) pushFront(e E) {
+	r.grow()
+	r.head = (r.head - 1 + len(r.buf)) % len(r.buf)
+	r.buf[r.head] = e
+	r.size++
+}
+
+func (r *ring[E]) popFront() E {
+	e := r.buf[r.head]
+	// clear the slot to not retain the element
+	r.buf[r.head] = zero[E]()
+	r.head = (r.head + 1) % len(r.buf)
+	r.size--
+	return e
+}
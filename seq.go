@@ -0,0 +1,130 @@
+package iter
+
+import "iter"
+
+// Seq converts the iterator to a range-over-func sequence.
+// The iterator is closed when the range loop terminates,
+// either because it is exhausted or because of a break.
+func Seq[E any](it Iter[E]) iter.Seq[E] {
+	return func(yield func(E) bool) {
+		defer Close(it)
+		for e, ok := it.Next(); ok; e, ok = it.Next() {
+			if !yield(e) {
+				return
+			}
+		}
+	}
+}
+
+// Seq2 converts an iterator of pairs to a range-over-func sequence of pairs.
+func Seq2[E, T any](it Iter[Pair[E, T]]) iter.Seq2[E, T] {
+	return func(yield func(E, T) bool) {
+		defer Close(it)
+		for p, ok := it.Next(); ok; p, ok = it.Next() {
+			if !yield(p.First, p.Second) {
+				return
+			}
+		}
+	}
+}
+
+// SeqEnum converts an iterator of enumerated values
+// to a range-over-func sequence of index-value pairs.
+func SeqEnum[E any](it Iter[Enum[E]]) iter.Seq2[int, E] {
+	return func(yield func(int, E) bool) {
+		defer Close(it)
+		for e, ok := it.Next(); ok; e, ok = it.Next() {
+			if !yield(e.Index, e.Value) {
+				return
+			}
+		}
+	}
+}
+
+// SeqMap converts an iterator of map entries
+// to a range-over-func sequence of key-value pairs.
+func SeqMap[K comparable, V any](it Iter[MapEntry[K, V]]) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		defer Close(it)
+		for e, ok := it.Next(); ok; e, ok = it.Next() {
+			if !yield(e.Key, e.Value) {
+				return
+			}
+		}
+	}
+}
+
+// pullIter is an iterator pulling its elements from a range-over-func sequence.
+// The sequence is started on the first call to Next and stopped as soon
+// as it is exhausted or Close is called.
+type pullIter[E any] struct {
+	pull func() (func() (E, bool), func())
+	next func() (E, bool)
+	stop func()
+	done bool
+}
+
+func (p *pullIter[E]) Next() (E, bool) {
+	if p.done {
+		return zero[E](), false
+	}
+	if p.next == nil {
+		p.next, p.stop = p.pull()
+	}
+	e, ok := p.next()
+	if !ok {
+		p.Close()
+	}
+	return e, ok
+}
+
+// Close stops the underlying sequence, releasing its resources.
+func (p *pullIter[E]) Close() error {
+	if p.stop != nil {
+		p.stop()
+	}
+	p.done = true
+	return nil
+}
+
+// FromSeq creates an iterator from a range-over-func sequence.
+// The returned iterator has a Close method that must be called
+// to release the sequence when it is abandoned before being exhausted.
+func FromSeq[E any](seq iter.Seq[E]) Iter[E] {
+	return &pullIter[E]{pull: func() (func() (E, bool), func()) {
+		return iter.Pull(seq)
+	}}
+}
+
+func fromSeq2[K, V, E any](seq iter.Seq2[K, V], f func(K, V) E) Iter[E] {
+	return &pullIter[E]{pull: func() (func() (E, bool), func()) {
+		next, stop := iter.Pull2(seq)
+		return func() (E, bool) {
+			k, v, ok := next()
+			if !ok {
+				return zero[E](), false
+			}
+			return f(k, v), true
+		}, stop
+	}}
+}
+
+// FromSeq2 creates an iterator of pairs from a range-over-func sequence of pairs.
+// As for FromSeq, the returned iterator must be closed when abandoned early.
+func FromSeq2[E, T any](seq iter.Seq2[E, T]) Iter[Pair[E, T]] {
+	return fromSeq2(seq, func(e E, t T) Pair[E, T] { return Pair[E, T]{First: e, Second: t} })
+}
+
+// FromSeqEnum creates an iterator of enumerated values
+// from a range-over-func sequence of index-value pairs.
+// As for FromSeq, the returned iterator must be closed when abandoned early.
+func FromSeqEnum[E any](seq iter.Seq2[int, E]) Iter[Enum[E]] {
+	return fromSeq2(seq, func(i int, e E) Enum[E] { return Enum[E]{Index: i, Value: e} })
+}
+
+// FromSeqMap creates an iterator of map entries
+// from a range-over-func sequence of key-value pairs.
+// As for FromSeq, the returned iterator must be closed when abandoned early.
+func FromSeqMap[K comparable, V any](seq iter.Seq2[K, V]) Iter[MapEntry[K, V]] {
+	return fromSeq2(seq, func(k K, v V) MapEntry[K, V] { return MapEntry[K, V]{Key: k, Value: v} })
+}
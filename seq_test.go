@@ -0,0 +1,160 @@
+package iter_test
+
+import (
+	"io"
+	"maps"
+	"reflect"
+	"slices"
+	"sort"
+	"testing"
+
+	"github.com/gmgigi96/iter"
+)
+
+func TestSeq(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []int
+		expected []int
+	}{
+		{"Seq with multiple elements", []int{1, 2, 3, 4}, []int{2, 4}},
+		{"Seq with empty slice", []int{}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			it := iter.Filter(iter.FromSlice(tt.input), func(e int) bool { return e%2 == 0 })
+			result := slices.Collect(iter.Seq(it))
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestSeqBreak(t *testing.T) {
+	it := iter.Range(10)
+	var result []int
+	for e := range iter.Seq(it) {
+		if e == 3 {
+			break
+		}
+		result = append(result, e)
+	}
+	expected := []int{0, 1, 2}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+	if e, _ := it.Next(); e != 4 {
+		t.Errorf("Expected iterator to resume from 4, got %v", e)
+	}
+}
+
+func TestSeq2(t *testing.T) {
+	it := iter.Zip(iter.FromSlice([]int{1, 2, 3}), iter.FromSlice([]string{"a", "b", "c"}))
+	result := maps.Collect(iter.Seq2(it))
+	expected := map[int]string{1: "a", 2: "b", 3: "c"}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
+
+func TestSeqEnum(t *testing.T) {
+	it := iter.Enumerate(iter.FromSlice([]string{"a", "b", "c"}))
+	result := maps.Collect(iter.SeqEnum(it))
+	expected := map[int]string{0: "a", 1: "b", 2: "c"}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
+
+func TestSeqMap(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2, "c": 3}
+	result := maps.Collect(iter.SeqMap(iter.FromMap(m)))
+	if !reflect.DeepEqual(result, m) {
+		t.Errorf("Expected %v, got %v", m, result)
+	}
+}
+
+func TestFromSeq(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []int
+		expected []string
+	}{
+		{"FromSeq with multiple elements", []int{1, 2, 3}, []string{"1", "2", "3"}},
+		{"FromSeq with empty slice", []int{}, []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			it := iter.FromSeq(slices.Values(tt.input))
+			result := iter.Slice(iter.Map(it, func(e int) string { return string(rune('0' + e)) }))
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestFromSeqClose(t *testing.T) {
+	var stopped bool
+	seq := func(yield func(int) bool) {
+		defer func() { stopped = true }()
+		for i := 0; ; i++ {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+
+	it := iter.FromSeq(seq)
+	if e, ok := it.Next(); !ok || e != 0 {
+		t.Fatalf("Expected 0, got %v", e)
+	}
+	if err := it.(io.Closer).Close(); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if !stopped {
+		t.Errorf("Expected sequence to be stopped")
+	}
+	if _, ok := it.Next(); ok {
+		t.Errorf("Expected closed iterator to be exhausted")
+	}
+}
+
+func TestFromSeq2(t *testing.T) {
+	m := map[int]string{1: "a", 2: "b", 3: "c"}
+
+	pairs := iter.Slice(iter.FromSeq2(maps.All(m)))
+	sort.Slice(pairs, func(i, j int) bool { return pairs[i].First < pairs[j].First })
+	expectedPairs := []iter.Pair[int, string]{{1, "a"}, {2, "b"}, {3, "c"}}
+	if !reflect.DeepEqual(pairs, expectedPairs) {
+		t.Errorf("Expected %v, got %v", expectedPairs, pairs)
+	}
+
+	entries := iter.Slice(iter.FromSeqMap(maps.All(m)))
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Key < entries[j].Key })
+	expectedEntries := []iter.MapEntry[int, string]{{1, "a"}, {2, "b"}, {3, "c"}}
+	if !reflect.DeepEqual(entries, expectedEntries) {
+		t.Errorf("Expected %v, got %v", expectedEntries, entries)
+	}
+
+	enums := iter.Slice(iter.FromSeqEnum(slices.All([]string{"a", "b"})))
+	expectedEnums := []iter.Enum[string]{{0, "a"}, {1, "b"}}
+	if !reflect.DeepEqual(enums, expectedEnums) {
+		t.Errorf("Expected %v, got %v", expectedEnums, enums)
+	}
+}
+
+func TestSeqBreakClosesUpstream(t *testing.T) {
+	src := &tracker{Iter: iter.Count(0, 1)}
+	for e := range iter.Seq(iter.Map(src, func(e int) int { return e * 2 })) {
+		if e > 4 {
+			break
+		}
+	}
+	if src.closed != 1 {
+		t.Errorf("Expected upstream to be closed once, got %d", src.closed)
+	}
+}
@@ -0,0 +1,120 @@
+package iter
+
+import "sync"
+
+// nopLocker is a sync.Locker that does nothing.
+type nopLocker struct{}
+
+func (nopLocker) Lock()   {}
+func (nopLocker) Unlock() {}
+
+// teeState is the state shared by the iterators returned by Tee.
+// It buffers the elements between the slowest and the fastest iterator.
+type teeState[E any] struct {
+	mu   sync.Locker
+	it   Iter[E]
+	buf  ring[E]
+	base int   // position of the first buffered element
+	pos  []int // position of each iterator, -1 once closed
+	open int
+	done bool
+}
+
+// release drops the buffered elements already read by all the open iterators.
+func (s *teeState[E]) release() {
+	slowest := -1
+	for _, p := range s.pos {
+		if p >= 0 && (slowest < 0 || p < slowest) {
+			slowest = p
+		}
+	}
+	if slowest < 0 {
+		slowest = s.base + s.buf.len()
+	}
+	for s.base < slowest {
+		s.buf.popFront()
+		s.base++
+	}
+}
+
+// teeIter is one of the iterators returned by Tee.
+type teeIter[E any] struct {
+	s *teeState[E]
+	i int
+}
+
+func (t *teeIter[E]) Next() (E, bool) {
+	s := t.s
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p := s.pos[t.i]
+	if p < 0 {
+		return zero[E](), false
+	}
+	var e E
+	if p-s.base < s.buf.len() {
+		e = s.buf.at(p - s.base)
+	} else {
+		if s.done {
+			return zero[E](), false
+		}
+		var ok bool
+		e, ok = s.it.Next()
+		if !ok {
+			s.done = true
+			return zero[E](), false
+		}
+		s.buf.pushBack(e)
+	}
+	s.pos[t.i]++
+	if p == s.base {
+		s.release()
+	}
+	return e, true
+}
+
+// Close detaches the iterator from the others, releasing the elements
+// buffered for it. When all the iterators are closed, the upstream
+// iterator is closed as well.
+func (t *teeIter[E]) Close() error {
+	s := t.s
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.pos[t.i] < 0 {
+		return nil
+	}
+	s.pos[t.i] = -1
+	s.open--
+	s.release()
+	if s.open == 0 {
+		return Close(s.it)
+	}
+	return nil
+}
+
+// Tee splits the iterator into n independent iterators.
+// The elements read from the upstream iterator are buffered
+// until all the returned iterators have read them, so memory
+// grows with the distance between the slowest and the fastest one.
+// The upstream iterator must not be used anymore after the call.
+// The returned iterators are not safe for concurrent use: see TeeSync.
+func Tee[E any](it Iter[E], n int) []Iter[E] {
+	return tee(it, n, nopLocker{})
+}
+
+// TeeSync is like Tee, but the returned iterators
+// can be consumed concurrently from different goroutines.
+func TeeSync[E any](it Iter[E], n int) []Iter[E] {
+	return tee(it, n, &sync.Mutex{})
+}
+
+func tee[E any](it Iter[E], n int, mu sync.Locker) []Iter[E] {
+	s := &teeState[E]{mu: mu, it: it, pos: make([]int, n), open: n}
+	its := make([]Iter[E], n)
+	for i := range its {
+		its[i] = &teeIter[E]{s: s, i: i}
+	}
+	return its
+}
@@ -0,0 +1,98 @@
+package iter_test
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/gmgigi96/iter"
+)
+
+func TestTee(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []int
+		n     int
+	}{
+		{"Tee into 3 iterators", []int{1, 2, 3, 4, 5}, 3},
+		{"Tee into 1 iterator", []int{1, 2, 3}, 1},
+		{"Tee from empty slice", []int{}, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			its := iter.Tee(iter.FromSlice(tt.input), tt.n)
+			if len(its) != tt.n {
+				t.Fatalf("Expected %d iterators, got %d", tt.n, len(its))
+			}
+			for _, it := range its {
+				result := iter.Slice(it)
+				if !reflect.DeepEqual(result, tt.input) {
+					t.Errorf("Expected %v, got %v", tt.input, result)
+				}
+			}
+		})
+	}
+}
+
+func TestTeeInterleaved(t *testing.T) {
+	its := iter.Tee(iter.Range(6), 2)
+	var first, second []int
+	for i := 0; i < 3; i++ {
+		e, _ := its[0].Next()
+		first = append(first, e)
+		e, _ = its[0].Next()
+		first = append(first, e)
+		e, _ = its[1].Next()
+		second = append(second, e)
+	}
+	second = append(second, iter.Slice(its[1])...)
+	expected := []int{0, 1, 2, 3, 4, 5}
+	if !reflect.DeepEqual(first, expected) {
+		t.Errorf("Expected %v, got %v", expected, first)
+	}
+	if !reflect.DeepEqual(second, expected) {
+		t.Errorf("Expected %v, got %v", expected, second)
+	}
+}
+
+func TestTeeClose(t *testing.T) {
+	src := &tracker{Iter: iter.Count(0, 1)}
+	its := iter.Tee[int](src, 2)
+	its[0].Next()
+	if err := iter.Close(its[0]); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if src.closed != 0 {
+		t.Errorf("Expected upstream to be open while an iterator is open")
+	}
+	if e, _ := its[1].Next(); e != 0 {
+		t.Errorf("Expected 0, got %v", e)
+	}
+	if err := iter.Close(its[1]); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if src.closed != 1 {
+		t.Errorf("Expected upstream to be closed once, got %d", src.closed)
+	}
+}
+
+func TestTeeSync(t *testing.T) {
+	input := iter.Slice(iter.Range(1000))
+	its := iter.TeeSync(iter.FromSlice(input), 4)
+	results := make([][]int, len(its))
+	var wg sync.WaitGroup
+	for i, it := range its {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = iter.Slice(it)
+		}()
+	}
+	wg.Wait()
+	for _, result := range results {
+		if !reflect.DeepEqual(result, input) {
+			t.Errorf("Expected all the elements in order, got %v", result)
+		}
+	}
+}
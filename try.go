@@ -0,0 +1,196 @@
+package iter
+
+// TryIter is an interface representing an iterator that may fail.
+// As for bufio.Scanner, Next returns false both when the iterator
+// is exhausted and when an error occurs: Err tells the two cases apart.
+type TryIter[E any] interface {
+	Iter[E]
+	// Err returns the first error encountered by the iterator, if any.
+	Err() error
+}
+
+// tryIter is a TryIter backed by a next function, an error function
+// and an optional close function.
+type tryIter[E any] struct {
+	next  func() (E, bool)
+	err   func() error
+	close func() error
+}
+
+func (t *tryIter[E]) Next() (E, bool) {
+	return t.next()
+}
+
+func (t *tryIter[E]) Err() error {
+	return t.err()
+}
+
+func (t *tryIter[E]) Close() error {
+	if t.close == nil {
+		return nil
+	}
+	return t.close()
+}
+
+// tryWrap returns a TryIter reading the elements from it,
+// reporting the errors returned by err and closing it on Close.
+func tryWrap[E any](it Iter[E], err func() error) TryIter[E] {
+	return &tryIter[E]{next: it.Next, err: err, close: func() error { return closeAll(it) }}
+}
+
+func noErr() error {
+	return nil
+}
+
+// firstErr returns the first non-nil error among the given functions.
+func firstErr(errs ...func() error) func() error {
+	return func() error {
+		for _, err := range errs {
+			if e := err(); e != nil {
+				return e
+			}
+		}
+		return nil
+	}
+}
+
+// Err returns the error encountered by the iterator if it is a TryIter,
+// or nil otherwise.
+func Err[E any](it Iter[E]) error {
+	if t, ok := it.(TryIter[E]); ok {
+		return t.Err()
+	}
+	return nil
+}
+
+// Try converts the iterator to a TryIter.
+// If the iterator is already a TryIter, it is returned as is.
+func Try[E any](it Iter[E]) TryIter[E] {
+	if t, ok := it.(TryIter[E]); ok {
+		return t
+	}
+	return tryWrap(it, noErr)
+}
+
+// FromTryFunc creates a TryIter from a function returning the next element,
+// whether there is one, and an error. The iteration stops at the first error.
+func FromTryFunc[E any](f func() (E, bool, error)) TryIter[E] {
+	var err error
+	return &tryIter[E]{
+		next: func() (E, bool) {
+			if err != nil {
+				return zero[E](), false
+			}
+			e, ok, ferr := f()
+			if ferr != nil {
+				err = ferr
+				return zero[E](), false
+			}
+			return e, ok
+		},
+		err: func() error { return err },
+	}
+}
+
+// TryFilter is the error-propagating counterpart of Filter.
+func TryFilter[E any](it TryIter[E], f func(E) bool) TryIter[E] {
+	return tryWrap(Filter[E](it, f), it.Err)
+}
+
+// TryMap is the error-propagating counterpart of Map.
+func TryMap[E, T any](it TryIter[E], f func(E) T) TryIter[T] {
+	return tryWrap(Map[E](it, f), it.Err)
+}
+
+// MapErr maps the elements of the iterator using a function that may fail.
+// The iteration stops at the first error returned either by f
+// or by the iterator itself, if it is a TryIter.
+func MapErr[E, T any](it Iter[E], f func(E) (T, error)) TryIter[T] {
+	var err error
+	return &tryIter[T]{
+		next: func() (T, bool) {
+			if err != nil {
+				return zero[T](), false
+			}
+			e, ok := it.Next()
+			if !ok {
+				return zero[T](), false
+			}
+			t, ferr := f(e)
+			if ferr != nil {
+				err = ferr
+				return zero[T](), false
+			}
+			return t, true
+		},
+		err:   firstErr(func() error { return err }, func() error { return Err(it) }),
+		close: func() error { return closeAll(it) },
+	}
+}
+
+// TryEnumerate is the error-propagating counterpart of Enumerate.
+func TryEnumerate[E any](it TryIter[E]) TryIter[Enum[E]] {
+	return tryWrap(Enumerate[E](it), it.Err)
+}
+
+// TryZip is the error-propagating counterpart of Zip.
+func TryZip[E, T any](it1 TryIter[E], it2 TryIter[T]) TryIter[Pair[E, T]] {
+	return tryWrap(Zip[E, T](it1, it2), firstErr(it1.Err, it2.Err))
+}
+
+// TryAccumulate is the error-propagating counterpart of Accumulate.
+func TryAccumulate[E any](it TryIter[E], f func(e1, e2 E) E, init E) TryIter[E] {
+	return tryWrap(Accumulate[E](it, f, init), it.Err)
+}
+
+// TryChain is the error-propagating counterpart of Chain.
+// Differently from Chain, the iteration stops at the first
+// iterator that fails, without moving on to the next ones.
+func TryChain[E any](it ...TryIter[E]) TryIter[E] {
+	upstream := anys(it)
+	curr, it := next(it...)
+	var err error
+	return &tryIter[E]{
+		next: func() (E, bool) {
+			for curr != nil {
+				e, ok := curr.Next()
+				if ok {
+					return e, true
+				}
+				if err = curr.Err(); err != nil {
+					curr = nil
+					break
+				}
+				curr, it = next(it...)
+			}
+			return zero[E](), false
+		},
+		err:   func() error { return err },
+		close: func() error { return closeAll(upstream...) },
+	}
+}
+
+// TryReduce is the error-propagating counterpart of Reduce.
+func TryReduce[E any](it TryIter[E], f func(e1, e2 E) E, init E) (E, error) {
+	res := Reduce[E](it, f, init)
+	if err := it.Err(); err != nil {
+		return zero[E](), err
+	}
+	return res, nil
+}
+
+// TrySlice is the error-propagating counterpart of Slice.
+func TrySlice[E any](it TryIter[E]) ([]E, error) {
+	s := Slice[E](it)
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// TryForEach is the error-propagating counterpart of ForEach.
+// It returns the error that stopped the iteration, if any.
+func TryForEach[E any](it TryIter[E], f func(E)) error {
+	ForEach[E](it, f)
+	return it.Err()
+}
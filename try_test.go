@@ -0,0 +1,155 @@
+package iter_test
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/gmgigi96/iter"
+)
+
+var errTest = errors.New("test error")
+
+// failing returns a TryIter yielding the given elements
+// and then failing with errTest.
+func failing[E any](s []E) iter.TryIter[E] {
+	var i int
+	return iter.FromTryFunc(func() (E, bool, error) {
+		if i < len(s) {
+			i++
+			return s[i-1], true, nil
+		}
+		var e E
+		return e, false, errTest
+	})
+}
+
+func TestFromTryFunc(t *testing.T) {
+	it := failing([]int{1, 2})
+	result := iter.Slice[int](it)
+	expected := []int{1, 2}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+	if err := it.Err(); !errors.Is(err, errTest) {
+		t.Errorf("Expected error %v, got %v", errTest, err)
+	}
+	if _, ok := it.Next(); ok {
+		t.Errorf("Expected failed iterator to be exhausted")
+	}
+}
+
+func TestErr(t *testing.T) {
+	if err := iter.Err(iter.Range(3)); err != nil {
+		t.Errorf("Expected no error for plain iterator, got %v", err)
+	}
+	it := failing([]int{})
+	it.Next()
+	if err := iter.Err[int](it); !errors.Is(err, errTest) {
+		t.Errorf("Expected error %v, got %v", errTest, err)
+	}
+}
+
+func TestMapErr(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    iter.Iter[string]
+		expected []int
+		err      error
+	}{
+		{"MapErr with valid elements", iter.FromSlice([]string{"1", "2", "3"}), []int{1, 2, 3}, nil},
+		{"MapErr failing on invalid element", iter.FromSlice([]string{"1", "x", "3"}), nil, strconv.ErrSyntax},
+		{"MapErr with failing upstream", failing([]string{"1", "2"}), nil, errTest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := iter.TrySlice(iter.MapErr(tt.input, strconv.Atoi))
+			if !errors.Is(err, tt.err) {
+				t.Errorf("Expected error %v, got %v", tt.err, err)
+			}
+			if tt.err == nil && !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestMapErrStopsPipeline(t *testing.T) {
+	var calls int
+	it := iter.MapErr(iter.Range(10), func(e int) (int, error) {
+		calls++
+		if e == 2 {
+			return 0, errTest
+		}
+		return e, nil
+	})
+	err := iter.TryForEach(iter.TryFilter(it, func(int) bool { return true }), func(int) {})
+	if !errors.Is(err, errTest) {
+		t.Errorf("Expected error %v, got %v", errTest, err)
+	}
+	if calls != 3 {
+		t.Errorf("Expected 3 calls, got %d", calls)
+	}
+}
+
+func TestTryCombinators(t *testing.T) {
+	double := func(e int) int { return e * 2 }
+	sum := func(a, b int) int { return a + b }
+
+	tests := []struct {
+		name string
+		fn   func(iter.TryIter[int]) error
+	}{
+		{"TryMap", func(it iter.TryIter[int]) error { _, err := iter.TrySlice(iter.TryMap(it, double)); return err }},
+		{"TryFilter", func(it iter.TryIter[int]) error {
+			_, err := iter.TrySlice(iter.TryFilter(it, func(int) bool { return true }))
+			return err
+		}},
+		{"TryEnumerate", func(it iter.TryIter[int]) error { _, err := iter.TrySlice(iter.TryEnumerate(it)); return err }},
+		{"TryAccumulate", func(it iter.TryIter[int]) error { _, err := iter.TrySlice(iter.TryAccumulate(it, sum, 0)); return err }},
+		{"TryReduce", func(it iter.TryIter[int]) error { _, err := iter.TryReduce(it, sum, 0); return err }},
+		{"TryZip", func(it iter.TryIter[int]) error {
+			_, err := iter.TrySlice(iter.TryZip(iter.Try(iter.Count(0, 1)), it))
+			return err
+		}},
+		{"TryForEach", func(it iter.TryIter[int]) error { return iter.TryForEach(it, func(int) {}) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.fn(iter.Try(iter.Range(3))); err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+			if err := tt.fn(failing([]int{1, 2})); !errors.Is(err, errTest) {
+				t.Errorf("Expected error %v, got %v", errTest, err)
+			}
+		})
+	}
+}
+
+func TestTryChain(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []iter.TryIter[int]
+		expected []int
+		err      error
+	}{
+		{"TryChain without errors", []iter.TryIter[int]{iter.Try(iter.Range(2)), iter.Try(iter.Range(1))}, []int{0, 1, 0}, nil},
+		{"TryChain stops at first failure", []iter.TryIter[int]{failing([]int{1}), iter.Try(iter.Range(2))}, []int{1}, errTest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			it := iter.TryChain(tt.input...)
+			result := iter.Slice[int](it)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+			if err := it.Err(); !errors.Is(err, tt.err) {
+				t.Errorf("Expected error %v, got %v", tt.err, err)
+			}
+		})
+	}
+}
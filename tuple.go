@@ -0,0 +1,108 @@
+package iter
+
+// unzipState is the state shared by the iterators returned by Unzip.
+type unzipState[E, T any] struct {
+	it      Iter[Pair[E, T]]
+	firsts  ring[E]
+	seconds ring[T]
+	closed1 bool
+	closed2 bool
+}
+
+// Unzip splits an iterator of pairs into an iterator of the first
+// and an iterator of the second elements. The elements read from the
+// upstream iterator by one of them are buffered for the other one,
+// until it reads them or is closed. When both are closed, the upstream
+// iterator is closed as well.
+func Unzip[E, T any](it Iter[Pair[E, T]]) (Iter[E], Iter[T]) {
+	s := &unzipState[E, T]{it: it}
+	firsts := &closeIter[E]{
+		next: func() (E, bool) {
+			if s.closed1 {
+				return zero[E](), false
+			}
+			if s.firsts.len() > 0 {
+				return s.firsts.popFront(), true
+			}
+			p, ok := s.it.Next()
+			if !ok {
+				return zero[E](), false
+			}
+			if !s.closed2 {
+				s.seconds.pushBack(p.Second)
+			}
+			return p.First, true
+		},
+		close: func() error {
+			if s.closed1 {
+				return nil
+			}
+			s.closed1 = true
+			s.firsts = ring[E]{}
+			if s.closed2 {
+				return Close(s.it)
+			}
+			return nil
+		},
+	}
+	seconds := &closeIter[T]{
+		next: func() (T, bool) {
+			if s.closed2 {
+				return zero[T](), false
+			}
+			if s.seconds.len() > 0 {
+				return s.seconds.popFront(), true
+			}
+			p, ok := s.it.Next()
+			if !ok {
+				return zero[T](), false
+			}
+			if !s.closed1 {
+				s.firsts.pushBack(p.First)
+			}
+			return p.Second, true
+		},
+		close: func() error {
+			if s.closed2 {
+				return nil
+			}
+			s.closed2 = true
+			s.seconds = ring[T]{}
+			if s.closed1 {
+				return Close(s.it)
+			}
+			return nil
+		},
+	}
+	return firsts, seconds
+}
+
+// Firsts returns an iterator of the first elements of the pairs.
+func Firsts[E, T any](it Iter[Pair[E, T]]) Iter[E] {
+	return Map(it, func(p Pair[E, T]) E { return p.First })
+}
+
+// Seconds returns an iterator of the second elements of the pairs.
+func Seconds[E, T any](it Iter[Pair[E, T]]) Iter[T] {
+	return Map(it, func(p Pair[E, T]) T { return p.Second })
+}
+
+// Swap swaps the elements of the pairs.
+func Swap[E, T any](it Iter[Pair[E, T]]) Iter[Pair[T, E]] {
+	return Map(it, func(p Pair[E, T]) Pair[T, E] { return Pair[T, E]{First: p.Second, Second: p.First} })
+}
+
+// Indexes returns an iterator of the indexes of the enumerated values.
+func Indexes[E any](it Iter[Enum[E]]) Iter[int] {
+	return Map(it, func(e Enum[E]) int { return e.Index })
+}
+
+// EnumValues returns an iterator of the enumerated values, without their indexes.
+func EnumValues[E any](it Iter[Enum[E]]) Iter[E] {
+	return Map(it, func(e Enum[E]) E { return e.Value })
+}
+
+// EntriesToPairs converts the map entries to pairs of keys and values.
+func EntriesToPairs[K comparable, V any](it Iter[MapEntry[K, V]]) Iter[Pair[K, V]] {
+	return Map(it, func(e MapEntry[K, V]) Pair[K, V] { return Pair[K, V]{First: e.Key, Second: e.Value} })
+}
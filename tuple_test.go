@@ -0,0 +1,100 @@
+package iter_test
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/gmgigi96/iter"
+)
+
+func TestUnzip(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   []iter.Pair[int, string]
+		firsts  []int
+		seconds []string
+	}{
+		{"Unzip multiple pairs", []iter.Pair[int, string]{{1, "a"}, {2, "b"}, {3, "c"}}, []int{1, 2, 3}, []string{"a", "b", "c"}},
+		{"Unzip empty slice", []iter.Pair[int, string]{}, []int{}, []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			firsts, seconds := iter.Unzip(iter.FromSlice(tt.input))
+			// read all the firsts before the seconds, forcing buffering
+			result1 := iter.Slice(firsts)
+			result2 := iter.Slice(seconds)
+			if !reflect.DeepEqual(result1, tt.firsts) {
+				t.Errorf("Expected %v, got %v", tt.firsts, result1)
+			}
+			if !reflect.DeepEqual(result2, tt.seconds) {
+				t.Errorf("Expected %v, got %v", tt.seconds, result2)
+			}
+		})
+	}
+}
+
+func TestUnzipInterleaved(t *testing.T) {
+	firsts, seconds := iter.Unzip(iter.Zip(iter.Range(4), iter.Range2(10, 14)))
+	var result1, result2 []int
+	for i := 0; i < 2; i++ {
+		e, _ := seconds.Next()
+		result2 = append(result2, e)
+		e, _ = firsts.Next()
+		result1 = append(result1, e)
+		e, _ = firsts.Next()
+		result1 = append(result1, e)
+	}
+	result2 = append(result2, iter.Slice(seconds)...)
+	if !reflect.DeepEqual(result1, []int{0, 1, 2, 3}) {
+		t.Errorf("Expected %v, got %v", []int{0, 1, 2, 3}, result1)
+	}
+	if !reflect.DeepEqual(result2, []int{10, 11, 12, 13}) {
+		t.Errorf("Expected %v, got %v", []int{10, 11, 12, 13}, result2)
+	}
+}
+
+func TestUnzipClose(t *testing.T) {
+	src := &tracker{Iter: iter.Range(3)}
+	firsts, seconds := iter.Unzip(iter.Zip[int, int](src, iter.Range(3)))
+	iter.Close(firsts)
+	if src.closed != 0 {
+		t.Errorf("Expected upstream to be open while an iterator is open")
+	}
+	if result := iter.Slice(seconds); !reflect.DeepEqual(result, []int{0, 1, 2}) {
+		t.Errorf("Expected %v, got %v", []int{0, 1, 2}, result)
+	}
+	iter.Close(seconds)
+	if src.closed != 1 {
+		t.Errorf("Expected upstream to be closed once, got %d", src.closed)
+	}
+}
+
+func TestProjections(t *testing.T) {
+	pairs := []iter.Pair[int, string]{{1, "a"}, {2, "b"}}
+
+	if result := iter.Slice(iter.Firsts(iter.FromSlice(pairs))); !reflect.DeepEqual(result, []int{1, 2}) {
+		t.Errorf("Expected %v, got %v", []int{1, 2}, result)
+	}
+	if result := iter.Slice(iter.Seconds(iter.FromSlice(pairs))); !reflect.DeepEqual(result, []string{"a", "b"}) {
+		t.Errorf("Expected %v, got %v", []string{"a", "b"}, result)
+	}
+	swapped := []iter.Pair[string, int]{{"a", 1}, {"b", 2}}
+	if result := iter.Slice(iter.Swap(iter.FromSlice(pairs))); !reflect.DeepEqual(result, swapped) {
+		t.Errorf("Expected %v, got %v", swapped, result)
+	}
+
+	if result := iter.Slice(iter.Indexes(iter.Enumerate(iter.FromSlice([]string{"x", "y"})))); !reflect.DeepEqual(result, []int{0, 1}) {
+		t.Errorf("Expected %v, got %v", []int{0, 1}, result)
+	}
+	if result := iter.Slice(iter.EnumValues(iter.Enumerate(iter.FromSlice([]string{"x", "y"})))); !reflect.DeepEqual(result, []string{"x", "y"}) {
+		t.Errorf("Expected %v, got %v", []string{"x", "y"}, result)
+	}
+
+	entries := iter.Slice(iter.EntriesToPairs(iter.FromMap(map[int]string{1: "a", 2: "b"})))
+	sort.Slice(entries, func(i, j int) bool { return entries[i].First < entries[j].First })
+	if !reflect.DeepEqual(entries, pairs) {
+		t.Errorf("Expected %v, got %v", pairs, entries)
+	}
+}
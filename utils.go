@@ -1,7 +1,33 @@
 package iter
 
+import (
+	"errors"
+	"io"
+)
+
 // zero return the zero value for the given type.
 func zero[T any]() T {
 	var t T
 	return t
 }
+
+// anys converts a slice of any type to a slice of empty interfaces.
+func anys[E any](s []E) []any {
+	res := make([]any, len(s))
+	for i, e := range s {
+		res[i] = e
+	}
+	return res
+}
+
+// closeAll closes the given iterators implementing io.Closer,
+// returning the errors they reported joined together.
+func closeAll(its ...any) error {
+	var errs []error
+	for _, it := range its {
+		if c, ok := it.(io.Closer); ok {
+			errs = append(errs, c.Close())
+		}
+	}
+	return errors.Join(errs...)
+}
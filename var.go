@@ -2,14 +2,14 @@ package iter
 
 // Accumulate accumulates the values of the iterator based on the provided function.
 func Accumulate[E any](it Iter[E], f func(e1, e2 E) E, init E) Iter[E] {
-	return IterFunc[E](func() (E, bool) {
+	return withClose(func() (E, bool) {
 		e, ok := it.Next()
 		if !ok {
 			return zero[E](), false
 		}
 		init = f(init, e)
 		return init, true
-	})
+	}, it)
 }
 
 // Reduce reduces the elements of the iterator to a single value based on the provided function.
@@ -23,6 +23,44 @@ func Reduce[E any](it Iter[E], f func(e1, e2 E) E, init E) E {
 	}
 }
 
+// Reduce1 is like Reduce, but uses the first element of the iterator
+// as initial value. It returns false if the iterator is empty.
+func Reduce1[E any](it Iter[E], f func(e1, e2 E) E) (E, bool) {
+	init, ok := it.Next()
+	if !ok {
+		return zero[E](), false
+	}
+	return Reduce(it, f, init), true
+}
+
+// Fold folds the elements of the iterator into an accumulator,
+// that can be of a different type than the elements.
+func Fold[E, A any](it Iter[E], init A, f func(A, E) A) A {
+	for e, ok := it.Next(); ok; e, ok = it.Next() {
+		init = f(init, e)
+	}
+	return init
+}
+
+// Scan returns the intermediate values of the accumulator
+// while folding the elements of the iterator, as Fold does.
+func Scan[E, A any](it Iter[E], init A, f func(A, E) A) Iter[A] {
+	return withClose(func() (A, bool) {
+		e, ok := it.Next()
+		if !ok {
+			return zero[A](), false
+		}
+		init = f(init, e)
+		return init, true
+	}, it)
+}
+
+// ScanInit is like Scan, but returns the initial value
+// of the accumulator first, as Haskell's scanl.
+func ScanInit[E, A any](it Iter[E], init A, f func(A, E) A) Iter[A] {
+	return Chain(Repeat(init, 1), Scan(it, init, f))
+}
+
 func next[E any](els ...E) (E, []E) {
 	if len(els) == 0 {
 		return zero[E](), nil
@@ -32,8 +70,9 @@ func next[E any](els ...E) (E, []E) {
 
 // Chain chains multiple iterators into one.
 func Chain[E any](it ...Iter[E]) Iter[E] {
+	upstream := anys(it)
 	curr, it := next(it...)
-	return IterFunc[E](func() (E, bool) {
+	return withClose(func() (E, bool) {
 		if curr == nil {
 			return zero[E](), false
 		}
@@ -51,13 +90,55 @@ func Chain[E any](it ...Iter[E]) Iter[E] {
 			}
 			return e, true
 		}
-	})
+	}, upstream...)
+}
+
+// Flatten chains the iterators returned by the iterator into one.
+// Differently from Chain, the iterators are read lazily,
+// so the outer iterator can be unbounded.
+func Flatten[E any](it Iter[Iter[E]]) Iter[E] {
+	var curr Iter[E]
+	return &closeIter[E]{
+		next: func() (E, bool) {
+			for {
+				if curr != nil {
+					if e, ok := curr.Next(); ok {
+						return e, true
+					}
+				}
+				inner, ok := it.Next()
+				if !ok {
+					curr = nil
+					return zero[E](), false
+				}
+				curr = inner
+			}
+		},
+		close: func() error { return closeAll(curr, it) },
+	}
+}
+
+// ChainFromIter is an alias for Flatten, named
+// after Python's itertools.chain.from_iterable.
+func ChainFromIter[E any](it Iter[Iter[E]]) Iter[E] {
+	return Flatten(it)
+}
+
+// FlattenSlices chains the slices returned by the iterator into one iterator.
+func FlattenSlices[E any](it Iter[[]E]) Iter[E] {
+	return Flatten(Map(it, FromSlice[E]))
+}
+
+// FlatMap maps each element of the iterator to an iterator
+// based on the provided function, and chains them into one.
+func FlatMap[E, T any](it Iter[E], f func(E) Iter[T]) Iter[T] {
+	return Flatten(Map(it, f))
 }
 
 // DropWhile drops elements from the iterator while the provided function returns true.
 func DropWhile[E any](it Iter[E], pred func(E) bool) Iter[E] {
 	var droppedAll bool
-	return IterFunc[E](func() (E, bool) {
+	return withClose(func() (E, bool) {
 		for {
 			e, ok := it.Next()
 			if !ok {
@@ -71,12 +152,12 @@ func DropWhile[E any](it Iter[E], pred func(E) bool) Iter[E] {
 			}
 			return e, true
 		}
-	})
+	}, it)
 }
 
 // FilterFalse filters out the elements for which the provided function returns true.
 func FilterFalse[E any](it Iter[E], pred func(E) bool) Iter[E] {
-	return IterFunc[E](func() (E, bool) {
+	return withClose(func() (E, bool) {
 		for {
 			e, ok := it.Next()
 			if !ok {
@@ -86,26 +167,43 @@ func FilterFalse[E any](it Iter[E], pred func(E) bool) Iter[E] {
 				return e, true
 			}
 		}
-	})
+	}, it)
 }
 
 // TakeWhile takes elements from the iterator while the provided function returns true.
+// The upstream iterator is closed as soon as the function returns false, unless
+// it is a PeekIter: in that case the element for which the function returned false
+// is pushed back, so that it can still be read from the PeekIter.
 func TakeWhile[E any](it Iter[E], pred func(E) bool) Iter[E] {
 	var taken bool
-	return IterFunc[E](func() (E, bool) {
-		if taken {
-			return zero[E](), false
-		}
-		e, ok := it.Next()
-		if !ok {
+	var closeErr error
+	return &closeIter[E]{
+		next: func() (E, bool) {
+			if taken {
+				return zero[E](), false
+			}
+			e, ok := it.Next()
+			if !ok {
+				return zero[E](), false
+			}
+			if pred(e) {
+				return e, true
+			}
+			taken = true
+			if p, ok := it.(*PeekIter[E]); ok {
+				p.Unread(e)
+				return zero[E](), false
+			}
+			closeErr = Close(it)
 			return zero[E](), false
-		}
-		if pred(e) {
-			return e, true
-		}
-		taken = true
-		return zero[E](), false
-	})
+		},
+		close: func() error {
+			if taken {
+				return closeErr
+			}
+			return Close(it)
+		},
+	}
 }
 
 // ForEach iterates through the iterator calling
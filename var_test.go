@@ -1,6 +1,7 @@
 package iter_test
 
 import (
+	"fmt"
 	"reflect"
 	"testing"
 
@@ -168,3 +169,139 @@ func TestForEach(t *testing.T) {
 		})
 	}
 }
+
+func TestTakeWhileClosesUpstream(t *testing.T) {
+	src := &tracker{Iter: iter.Count(0, 1)}
+	result := iter.Slice(iter.TakeWhile(src, func(e int) bool { return e < 3 }))
+	if !reflect.DeepEqual(result, []int{0, 1, 2}) {
+		t.Errorf("Expected %v, got %v", []int{0, 1, 2}, result)
+	}
+	if src.closed != 1 {
+		t.Errorf("Expected upstream to be closed once, got %d", src.closed)
+	}
+}
+
+func TestFlatten(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    [][]int
+		expected []int
+	}{
+		{"Flatten multiple slices", [][]int{{1, 2}, {3}, {4, 5}}, []int{1, 2, 3, 4, 5}},
+		{"Flatten with empty inner slices", [][]int{{}, {1}, {}, {}, {2}, {}}, []int{1, 2}},
+		{"Flatten empty outer slice", [][]int{}, []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := iter.Slice(iter.FlattenSlices(iter.FromSlice(tt.input)))
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+			inner := iter.Map(iter.FromSlice(tt.input), iter.FromSlice[int])
+			result = iter.Slice(iter.ChainFromIter(inner))
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestFlatMap(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []int
+		expected []int
+	}{
+		{"FlatMap to ranges", []int{1, 0, 3}, []int{0, 0, 1, 2}},
+		{"FlatMap empty slice", []int{}, []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := iter.Slice(iter.FlatMap(iter.FromSlice(tt.input), iter.Range))
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestFlattenUnbounded(t *testing.T) {
+	// many consecutive empty inner iterators must not recurse
+	it := iter.FlatMap(iter.Count(0, 1), func(e int) iter.Iter[int] {
+		if e%100000 != 0 {
+			return iter.Range(0)
+		}
+		return iter.Repeat(e, 1)
+	})
+	result := iter.Slice(iter.Take(it, 3))
+	expected := []int{0, 100000, 200000}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
+
+func TestReduce1(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []int
+		expected int
+		ok       bool
+	}{
+		{"Reduce1 with max", []int{3, 7, 2}, 7, true},
+		{"Reduce1 single element", []int{4}, 4, true},
+		{"Reduce1 empty slice", []int{}, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, ok := iter.Reduce1(iter.FromSlice(tt.input), func(a, b int) int { return max(a, b) })
+			if result != tt.expected || ok != tt.ok {
+				t.Errorf("Expected %v, %v, got %v, %v", tt.expected, tt.ok, result, ok)
+			}
+		})
+	}
+}
+
+func TestFold(t *testing.T) {
+	counts := iter.Fold(iter.FromSlice([]string{"a", "b", "a"}), map[string]int{}, func(m map[string]int, s string) map[string]int {
+		m[s]++
+		return m
+	})
+	expected := map[string]int{"a": 2, "b": 1}
+	if !reflect.DeepEqual(counts, expected) {
+		t.Errorf("Expected %v, got %v", expected, counts)
+	}
+
+	joined := iter.Fold(iter.Range(4), "", func(s string, e int) string { return s + fmt.Sprint(e) })
+	if joined != "0123" {
+		t.Errorf("Expected 0123, got %v", joined)
+	}
+}
+
+func TestScan(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []int
+		expected []string
+		initial  []string
+	}{
+		{"Scan multiple elements", []int{1, 2, 3}, []string{"1", "12", "123"}, []string{"", "1", "12", "123"}},
+		{"Scan empty slice", []int{}, []string{}, []string{""}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := func(s string, e int) string { return s + fmt.Sprint(e) }
+			result := iter.Slice(iter.Scan(iter.FromSlice(tt.input), "", f))
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+			result = iter.Slice(iter.ScanInit(iter.FromSlice(tt.input), "", f))
+			if !reflect.DeepEqual(result, tt.initial) {
+				t.Errorf("Expected %v, got %v", tt.initial, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package iter
+
+// Chunk splits the elements of the iterator into slices of size n.
+// The last slice may be shorter if the elements are not enough to fill it.
+func Chunk[E any](it Iter[E], n int) Iter[[]E] {
+	if n <= 0 {
+		panic("size must be positive")
+	}
+	var done bool
+	return withClose(func() ([]E, bool) {
+		if done {
+			return nil, false
+		}
+		chunk := make([]E, 0, n)
+		for len(chunk) < n {
+			e, ok := it.Next()
+			if !ok {
+				done = true
+				break
+			}
+			chunk = append(chunk, e)
+		}
+		if len(chunk) == 0 {
+			return nil, false
+		}
+		return chunk, true
+	}, it)
+}
+
+// Window returns an iterator of sliding windows of size n over the elements
+// of the iterator, each one starting step elements after the previous one.
+// Elements not filling a whole window at the end are discarded.
+func Window[E any](it Iter[E], n, step int) Iter[[]E] {
+	return window(it, n, step, false)
+}
+
+// WindowReuse is like Window, but returns the same slice on each
+// call to Next, overwriting its content to avoid allocations.
+// The slice must not be retained across calls to Next.
+func WindowReuse[E any](it Iter[E], n, step int) Iter[[]E] {
+	return window(it, n, step, true)
+}
+
+func window[E any](it Iter[E], n, step int, reuse bool) Iter[[]E] {
+	if n <= 0 {
+		panic("size must be positive")
+	}
+	if step <= 0 {
+		panic("step must be positive")
+	}
+	var buf ring[E]
+	var out []E
+	var started, done bool
+	return withClose(func() ([]E, bool) {
+		if done {
+			return nil, false
+		}
+		if started {
+			skip := step
+			for ; skip > 0 && buf.len() > 0; skip-- {
+				buf.popFront()
+			}
+			for ; skip > 0; skip-- {
+				if _, ok := it.Next(); !ok {
+					done = true
+					return nil, false
+				}
+			}
+		}
+		started = true
+		for buf.len() < n {
+			e, ok := it.Next()
+			if !ok {
+				done = true
+				return nil, false
+			}
+			buf.pushBack(e)
+		}
+		if !reuse || out == nil {
+			out = make([]E, n)
+		}
+		for i := range out {
+			out[i] = buf.at(i)
+		}
+		return out, true
+	}, it)
+}
+
+// Pairwise returns an iterator of the overlapping pairs
+// of consecutive elements of the iterator.
+func Pairwise[E any](it Iter[E]) Iter[Pair[E, E]] {
+	var prev E
+	var started bool
+	return withClose(func() (Pair[E, E], bool) {
+		if !started {
+			started = true
+			e, ok := it.Next()
+			if !ok {
+				return zero[Pair[E, E]](), false
+			}
+			prev = e
+		}
+		e, ok := it.Next()
+		if !ok {
+			return zero[Pair[E, E]](), false
+		}
+		p := Pair[E, E]{First: prev, Second: e}
+		prev = e
+		return p, true
+	}, it)
+}
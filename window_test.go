@@ -0,0 +1,93 @@
+package iter_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gmgigi96/iter"
+)
+
+func TestChunk(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []int
+		n        int
+		expected [][]int
+	}{
+		{"Chunk with exact size", []int{1, 2, 3, 4}, 2, [][]int{{1, 2}, {3, 4}}},
+		{"Chunk with short final batch", []int{1, 2, 3, 4, 5}, 2, [][]int{{1, 2}, {3, 4}, {5}}},
+		{"Chunk larger than input", []int{1, 2}, 5, [][]int{{1, 2}}},
+		{"Chunk from empty slice", []int{}, 3, [][]int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := iter.Slice(iter.Chunk(iter.FromSlice(tt.input), tt.n))
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestWindow(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []int
+		n        int
+		step     int
+		expected [][]int
+	}{
+		{"Window of size 3 with step 1", []int{1, 2, 3, 4, 5}, 3, 1, [][]int{{1, 2, 3}, {2, 3, 4}, {3, 4, 5}}},
+		{"Window of size 2 with step 2", []int{1, 2, 3, 4, 5}, 2, 2, [][]int{{1, 2}, {3, 4}}},
+		{"Window with step larger than size", []int{1, 2, 3, 4, 5, 6, 7}, 2, 3, [][]int{{1, 2}, {4, 5}}},
+		{"Window larger than input", []int{1, 2}, 3, 1, [][]int{}},
+		{"Window from empty slice", []int{}, 2, 1, [][]int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := iter.Slice(iter.Window(iter.FromSlice(tt.input), tt.n, tt.step))
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+
+			result = iter.Slice(iter.Map(iter.WindowReuse(iter.FromSlice(tt.input), tt.n, tt.step), func(w []int) []int {
+				return append([]int(nil), w...)
+			}))
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestWindowReuseSameSlice(t *testing.T) {
+	it := iter.WindowReuse(iter.Range(4), 2, 1)
+	w1, _ := it.Next()
+	w2, _ := it.Next()
+	if &w1[0] != &w2[0] {
+		t.Errorf("Expected the same slice to be reused")
+	}
+}
+
+func TestPairwise(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []int
+		expected []iter.Pair[int, int]
+	}{
+		{"Pairwise with multiple elements", []int{1, 2, 3, 4}, []iter.Pair[int, int]{{1, 2}, {2, 3}, {3, 4}}},
+		{"Pairwise with single element", []int{1}, []iter.Pair[int, int]{}},
+		{"Pairwise from empty slice", []int{}, []iter.Pair[int, int]{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := iter.Slice(iter.Pairwise(iter.FromSlice(tt.input)))
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
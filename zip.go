@@ -0,0 +1,107 @@
+package iter
+
+import "errors"
+
+// ErrLengthMismatch is the error reported by ZipStrict
+// when the iterators have different lengths.
+var ErrLengthMismatch = errors.New("iter: iterators have different lengths")
+
+// ZipLongest zips two iterators into one, stopping at the longest one.
+// The missing elements of the shortest iterator are replaced by fill1 or fill2.
+func ZipLongest[E, T any](it1 Iter[E], it2 Iter[T], fill1 E, fill2 T) Iter[Pair[E, T]] {
+	var done1, done2 bool
+	return withClose(func() (Pair[E, T], bool) {
+		first, second := fill1, fill2
+		if !done1 {
+			if e, ok := it1.Next(); ok {
+				first = e
+			} else {
+				done1 = true
+			}
+		}
+		if !done2 {
+			if e, ok := it2.Next(); ok {
+				second = e
+			} else {
+				done2 = true
+			}
+		}
+		if done1 && done2 {
+			return zero[Pair[E, T]](), false
+		}
+		return Pair[E, T]{First: first, Second: second}, true
+	}, it1, it2)
+}
+
+// Zip3 zips three iterators into one, stopping at the shortest one.
+func Zip3[E, T, U any](it1 Iter[E], it2 Iter[T], it3 Iter[U]) Iter[Triple[E, T, U]] {
+	return withClose(func() (Triple[E, T, U], bool) {
+		first, ok := it1.Next()
+		if !ok {
+			return zero[Triple[E, T, U]](), false
+		}
+		second, ok := it2.Next()
+		if !ok {
+			return zero[Triple[E, T, U]](), false
+		}
+		third, ok := it3.Next()
+		if !ok {
+			return zero[Triple[E, T, U]](), false
+		}
+		return Triple[E, T, U]{First: first, Second: second, Third: third}, true
+	}, it1, it2, it3)
+}
+
+// ZipN zips any number of iterators of the same type into one,
+// stopping at the shortest one. Without iterators, it yields nothing.
+func ZipN[E any](its ...Iter[E]) Iter[[]E] {
+	return withClose(func() ([]E, bool) {
+		if len(its) == 0 {
+			return nil, false
+		}
+		res := make([]E, len(its))
+		for i, it := range its {
+			e, ok := it.Next()
+			if !ok {
+				return nil, false
+			}
+			res[i] = e
+		}
+		return res, true
+	}, anys(its)...)
+}
+
+// ZipStrict zips two iterators into one, like Zip, but fails with
+// ErrLengthMismatch if one of them is exhausted before the other.
+// The errors of the iterators are propagated as well.
+func ZipStrict[E, T any](it1 Iter[E], it2 Iter[T]) TryIter[Pair[E, T]] {
+	var err error
+	return &tryIter[Pair[E, T]]{
+		next: func() (Pair[E, T], bool) {
+			if err != nil {
+				return zero[Pair[E, T]](), false
+			}
+			first, ok1 := it1.Next()
+			if !ok1 {
+				if err = Err(it1); err != nil {
+					return zero[Pair[E, T]](), false
+				}
+			}
+			second, ok2 := it2.Next()
+			if !ok2 {
+				if err = Err(it2); err != nil {
+					return zero[Pair[E, T]](), false
+				}
+			}
+			if ok1 != ok2 {
+				err = ErrLengthMismatch
+			}
+			if !ok1 || !ok2 {
+				return zero[Pair[E, T]](), false
+			}
+			return Pair[E, T]{First: first, Second: second}, true
+		},
+		err:   func() error { return err },
+		close: func() error { return closeAll(it1, it2) },
+	}
+}
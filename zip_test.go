@@ -0,0 +1,104 @@
+package iter_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/gmgigi96/iter"
+)
+
+func TestZipLongest(t *testing.T) {
+	tests := []struct {
+		name     string
+		input1   []int
+		input2   []string
+		expected []iter.Pair[int, string]
+	}{
+		{"ZipLongest same lengths", []int{1, 2}, []string{"a", "b"}, []iter.Pair[int, string]{{1, "a"}, {2, "b"}}},
+		{"ZipLongest first shorter", []int{1}, []string{"a", "b"}, []iter.Pair[int, string]{{1, "a"}, {-1, "b"}}},
+		{"ZipLongest second shorter", []int{1, 2, 3}, []string{"a"}, []iter.Pair[int, string]{{1, "a"}, {2, "-"}, {3, "-"}}},
+		{"ZipLongest empty slices", []int{}, []string{}, []iter.Pair[int, string]{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := iter.Slice(iter.ZipLongest(iter.FromSlice(tt.input1), iter.FromSlice(tt.input2), -1, "-"))
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestZip3(t *testing.T) {
+	result := iter.Slice(iter.Zip3(iter.Range(3), iter.FromSlice([]string{"a", "b"}), iter.FromSlice([]bool{true, false, true})))
+	expected := []iter.Triple[int, string, bool]{{0, "a", true}, {1, "b", false}}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
+
+func TestZipN(t *testing.T) {
+	tests := []struct {
+		name     string
+		inputs   [][]int
+		expected [][]int
+	}{
+		{"ZipN three slices", [][]int{{1, 2}, {3, 4}, {5, 6}}, [][]int{{1, 3, 5}, {2, 4, 6}}},
+		{"ZipN mismatched lengths", [][]int{{1, 2}, {3}}, [][]int{{1, 3}}},
+		{"ZipN without iterators", [][]int{}, [][]int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			its := make([]iter.Iter[int], len(tt.inputs))
+			for i, in := range tt.inputs {
+				its[i] = iter.FromSlice(in)
+			}
+			result := iter.Slice(iter.ZipN(its...))
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestZipStrict(t *testing.T) {
+	tests := []struct {
+		name     string
+		input1   iter.Iter[int]
+		input2   iter.Iter[int]
+		expected []iter.Pair[int, int]
+		err      error
+	}{
+		{"ZipStrict same lengths", iter.Range(2), iter.Range2(5, 7), []iter.Pair[int, int]{{0, 5}, {1, 6}}, nil},
+		{"ZipStrict first shorter", iter.Range(1), iter.Range(2), nil, iter.ErrLengthMismatch},
+		{"ZipStrict second shorter", iter.Range(2), iter.Range(1), nil, iter.ErrLengthMismatch},
+		{"ZipStrict failing iterator", failing([]int{1}), iter.Range(2), nil, errTest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := iter.TrySlice(iter.ZipStrict(tt.input1, tt.input2))
+			if !errors.Is(err, tt.err) {
+				t.Errorf("Expected error %v, got %v", tt.err, err)
+			}
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestZipPeekableKeepsElement(t *testing.T) {
+	p := iter.Peekable(iter.Range(4))
+	pairs := iter.Slice(iter.Zip[int, string](p, iter.FromSlice([]string{"a", "b"})))
+	if len(pairs) != 2 {
+		t.Fatalf("Expected 2 pairs, got %v", pairs)
+	}
+	rest := iter.Slice[int](p)
+	if !reflect.DeepEqual(rest, []int{2, 3}) {
+		t.Errorf("Expected %v, got %v", []int{2, 3}, rest)
+	}
+}